@@ -0,0 +1,295 @@
+// Package resource provides access to language resources (lexicons, language
+// models, normalization tables, segmentation weights) through a single
+// abstraction over fs.FS. A Bundle can be backed by an embedded file system,
+// a directory or a zip archive, and optionally carries a manifest with
+// checksums so that missing or corrupted resources are reported instead of
+// silently replaced with empty data.
+//
+// The FS constructors of the other packages read a bundle laid out as
+//
+//	manifest.json                        optional, see Manifest
+//	lexicon.bin                          morphology.CreateFromFS
+//	lm.2gram.slm                         language model, or lm/lm.2gram.slm
+//	normalization/*.txt                  normalization tables
+//	normalization/endings.txt            spell checker endings
+//	sentence-boundary/weights.csv        sentence boundary weights
+//	sentence-boundary/abbreviations.txt  sentence extractor abbreviations
+//
+// Normalization tables may also be stored without the .txt extension, as
+// the Java data ships them. A manifest lists the files it checks with these
+// paths.
+package resource
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"os"
+	"strings"
+)
+
+// EnvDataRoot is the environment variable consulted by FromEnv
+const EnvDataRoot = "ZEMBEREK_DATA_ROOT"
+
+// DefaultDataRoot is used by FromEnv when EnvDataRoot is not set
+const DefaultDataRoot = "data"
+
+// ErrChecksumMismatch is returned when a file does not match its manifest entry
+var ErrChecksumMismatch = errors.New("resource: checksum mismatch")
+
+// MissingError reports resources that were required but not found in a bundle
+type MissingError struct {
+	Source string
+	Names  []string
+}
+
+func (e *MissingError) Error() string {
+	return fmt.Sprintf("resource: missing in %s: %s", e.Source, strings.Join(e.Names, ", "))
+}
+
+// Unwrap lets errors.Is(err, fs.ErrNotExist) match a MissingError
+func (e *MissingError) Unwrap() error {
+	return fs.ErrNotExist
+}
+
+// Bundle is a read-only set of resources. It implements fs.FS, so it can be
+// passed to every loader that accepts an fs.FS. Files listed in the manifest
+// are verified against their checksum when read to the end.
+type Bundle struct {
+	fsys     fs.FS
+	manifest *Manifest
+	source   string
+	closer   io.Closer
+}
+
+// New creates a bundle over fsys. If fsys contains a manifest.json it is
+// loaded and used for verification. source is a human readable description
+// used in error messages.
+func New(fsys fs.FS, source string) (*Bundle, error) {
+	b := &Bundle{fsys: fsys, source: source}
+
+	f, err := fsys.Open(ManifestName)
+	if err == nil {
+		defer f.Close()
+		m, err := ReadManifest(f)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", source, err)
+		}
+		b.manifest = m
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("resource: open manifest in %s: %w", source, err)
+	}
+
+	return b, nil
+}
+
+// Dir creates a bundle from a directory on disk
+func Dir(path string) (*Bundle, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("resource: %w", err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("resource: %s is not a directory", path)
+	}
+	return New(os.DirFS(path), path)
+}
+
+// Zip creates a bundle from a zip archive on disk. The returned bundle must
+// be closed to release the archive.
+func Zip(path string) (*Bundle, error) {
+	rc, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, fmt.Errorf("resource: %w", err)
+	}
+	b, err := New(rc, path)
+	if err != nil {
+		rc.Close()
+		return nil, err
+	}
+	b.closer = rc
+	return b, nil
+}
+
+// ZipBytes creates a bundle from an in-memory zip archive
+func ZipBytes(data []byte, source string) (*Bundle, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("resource: %w", err)
+	}
+	return New(zr, source)
+}
+
+// Sub creates a bundle rooted at dir inside fsys. It is mostly used with
+// embed.FS, whose paths include the embedding directory.
+func Sub(fsys fs.FS, dir string) (*Bundle, error) {
+	sub, err := fs.Sub(fsys, dir)
+	if err != nil {
+		return nil, fmt.Errorf("resource: %w", err)
+	}
+	return New(sub, dir)
+}
+
+// Open opens a path that may be a directory or a .zip archive
+func Open(path string) (*Bundle, error) {
+	if strings.HasSuffix(strings.ToLower(path), ".zip") {
+		return Zip(path)
+	}
+	return Dir(path)
+}
+
+// FromEnv opens the bundle pointed to by ZEMBEREK_DATA_ROOT, falling back to
+// DefaultDataRoot relative to the working directory
+func FromEnv() (*Bundle, error) {
+	root := os.Getenv(EnvDataRoot)
+	if root == "" {
+		root = DefaultDataRoot
+	}
+	return Open(root)
+}
+
+// Source returns the description of where the bundle was loaded from
+func (b *Bundle) Source() string {
+	return b.source
+}
+
+// String returns string representation
+func (b *Bundle) String() string {
+	return "Bundle(" + b.source + ")"
+}
+
+// Manifest returns the bundle manifest, or nil if the bundle has none
+func (b *Bundle) Manifest() *Manifest {
+	return b.manifest
+}
+
+// Close releases the underlying archive, if any
+func (b *Bundle) Close() error {
+	if b.closer == nil {
+		return nil
+	}
+	return b.closer.Close()
+}
+
+// Open opens the named file. Regular files listed in the manifest are wrapped
+// so that reading them to EOF verifies their size and checksum.
+func (b *Bundle) Open(name string) (fs.File, error) {
+	f, err := b.fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	if b.manifest == nil {
+		return f, nil
+	}
+	entry, ok := b.manifest.Files[name]
+	if !ok {
+		return f, nil
+	}
+	return &verifyingFile{File: f, name: name, entry: entry, hash: sha256.New()}, nil
+}
+
+// ReadFile reads the named file and verifies it against the manifest
+func (b *Bundle) ReadFile(name string) ([]byte, error) {
+	f, err := b.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return io.ReadAll(f)
+}
+
+// Exists reports whether the named file exists in the bundle
+func (b *Bundle) Exists(name string) bool {
+	_, err := fs.Stat(b.fsys, name)
+	return err == nil
+}
+
+// FirstExisting returns the first of names that exists in the bundle
+func (b *Bundle) FirstExisting(names ...string) (string, bool) {
+	for _, name := range names {
+		if name != "" && b.Exists(name) {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// Require returns a *MissingError listing every name that does not exist
+func (b *Bundle) Require(names ...string) error {
+	var missing []string
+	for _, name := range names {
+		if !b.Exists(name) {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return &MissingError{Source: b.source, Names: missing}
+	}
+	return nil
+}
+
+// Verify checks every file listed in the manifest. Bundles without a manifest
+// always verify.
+func (b *Bundle) Verify() error {
+	if b.manifest == nil {
+		return nil
+	}
+	if err := b.Require(b.manifest.FileNames()...); err != nil {
+		return err
+	}
+	for _, name := range b.manifest.FileNames() {
+		f, err := b.Open(name)
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(io.Discard, f)
+		f.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// verifyingFile hashes everything read through it and compares the result
+// with the manifest entry once the end of the file is reached
+type verifyingFile struct {
+	fs.File
+	name  string
+	entry FileEntry
+	hash  hash.Hash
+	size  int64
+	done  bool
+}
+
+func (f *verifyingFile) Read(p []byte) (int, error) {
+	n, err := f.File.Read(p)
+	if n > 0 {
+		f.hash.Write(p[:n])
+		f.size += int64(n)
+	}
+	if err == io.EOF && !f.done {
+		f.done = true
+		if verr := f.check(); verr != nil {
+			return n, verr
+		}
+	}
+	return n, err
+}
+
+func (f *verifyingFile) check() error {
+	if f.size != f.entry.Size {
+		return fmt.Errorf("%w: %s: size %d, expected %d", ErrChecksumMismatch, f.name, f.size, f.entry.Size)
+	}
+	sum := hex.EncodeToString(f.hash.Sum(nil))
+	if f.entry.SHA256 != "" && !strings.EqualFold(sum, f.entry.SHA256) {
+		return fmt.Errorf("%w: %s: sha256 %s, expected %s", ErrChecksumMismatch, f.name, sum, f.entry.SHA256)
+	}
+	return nil
+}
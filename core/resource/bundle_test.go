@@ -0,0 +1,231 @@
+package resource
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+)
+
+func testFS() fstest.MapFS {
+	return fstest.MapFS{
+		"lexicon.bin":                   {Data: []byte("lexicon")},
+		"normalization/split.txt":       {Data: []byte("birşey=bir şey\n")},
+		"normalization/no-split.txt":    {Data: []byte("herkes\n")},
+		"sentence-boundary/weights.csv": {Data: []byte("a\t1.0\n")},
+	}
+}
+
+func withManifest(t *testing.T, fsys fstest.MapFS) fstest.MapFS {
+	t.Helper()
+	m, err := GenerateManifest(fsys, "test", "1")
+	if err != nil {
+		t.Fatalf("GenerateManifest: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := m.Write(&buf); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	fsys[ManifestName] = &fstest.MapFile{Data: buf.Bytes()}
+	return fsys
+}
+
+func TestGenerateManifest(t *testing.T) {
+	m, err := GenerateManifest(testFS(), "test", "1")
+	if err != nil {
+		t.Fatalf("GenerateManifest: %v", err)
+	}
+	if len(m.Files) != 4 {
+		t.Fatalf("expected 4 files, got %d", len(m.Files))
+	}
+	entry := m.Files["lexicon.bin"]
+	if entry.Size != 7 {
+		t.Errorf("expected size 7, got %d", entry.Size)
+	}
+	sum := sha256.Sum256([]byte("lexicon"))
+	if entry.SHA256 != hex.EncodeToString(sum[:]) {
+		t.Errorf("unexpected digest %q", entry.SHA256)
+	}
+}
+
+func TestBundleVerify(t *testing.T) {
+	fsys := withManifest(t, testFS())
+	b, err := New(fsys, "test")
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if b.Manifest() == nil {
+		t.Fatal("expected manifest to be loaded")
+	}
+	if err := b.Verify(); err != nil {
+		t.Fatalf("Verify: %v", err)
+	}
+
+	data, err := b.ReadFile("normalization/split.txt")
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+	if string(data) != "birşey=bir şey\n" {
+		t.Errorf("unexpected content %q", data)
+	}
+}
+
+func TestBundleChecksumMismatch(t *testing.T) {
+	fsys := withManifest(t, testFS())
+	fsys["lexicon.bin"] = &fstest.MapFile{Data: []byte("lexicoN")}
+
+	b, err := New(fsys, "test")
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if _, err := b.ReadFile("lexicon.bin"); !errors.Is(err, ErrChecksumMismatch) {
+		t.Errorf("ReadFile: expected ErrChecksumMismatch, got %v", err)
+	}
+	if err := b.Verify(); !errors.Is(err, ErrChecksumMismatch) {
+		t.Errorf("Verify: expected ErrChecksumMismatch, got %v", err)
+	}
+
+	// Files through fs.ReadFile are verified as well
+	if _, err := fs.ReadFile(b, "lexicon.bin"); !errors.Is(err, ErrChecksumMismatch) {
+		t.Errorf("fs.ReadFile: expected ErrChecksumMismatch, got %v", err)
+	}
+}
+
+func TestBundleMissingFiles(t *testing.T) {
+	fsys := withManifest(t, testFS())
+	delete(fsys, "normalization/split.txt")
+
+	b, err := New(fsys, "test")
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	err = b.Verify()
+	var missing *MissingError
+	if !errors.As(err, &missing) {
+		t.Fatalf("expected MissingError, got %v", err)
+	}
+	if len(missing.Names) != 1 || missing.Names[0] != "normalization/split.txt" {
+		t.Errorf("unexpected missing names %v", missing.Names)
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		t.Error("MissingError should match fs.ErrNotExist")
+	}
+
+	if err := b.Require("lexicon.bin", "lm/lm.2gram.slm"); err == nil {
+		t.Error("expected Require to fail for lm/lm.2gram.slm")
+	}
+
+	name, ok := b.FirstExisting("normalization/split", "normalization/no-split.txt")
+	if !ok || name != "normalization/no-split.txt" {
+		t.Errorf("FirstExisting returned %q, %v", name, ok)
+	}
+}
+
+func TestBundleWithoutManifest(t *testing.T) {
+	b, err := New(testFS(), "test")
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if b.Manifest() != nil {
+		t.Error("expected no manifest")
+	}
+	if err := b.Verify(); err != nil {
+		t.Errorf("Verify: %v", err)
+	}
+	if !b.Exists("lexicon.bin") || b.Exists("missing.txt") {
+		t.Error("unexpected Exists result")
+	}
+}
+
+func TestBundleInvalidManifest(t *testing.T) {
+	fsys := testFS()
+	fsys[ManifestName] = &fstest.MapFile{Data: []byte(`{"files": {"../escape": {"size": 1}}}`)}
+	if _, err := New(fsys, "test"); err == nil {
+		t.Error("expected error for manifest with invalid path")
+	}
+}
+
+func writeZip(t *testing.T, fsys fstest.MapFS) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, file := range fsys {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatalf("zip create: %v", err)
+		}
+		if _, err := w.Write(file.Data); err != nil {
+			t.Fatalf("zip write: %v", err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("zip close: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestZipBundle(t *testing.T) {
+	data := writeZip(t, withManifest(t, testFS()))
+
+	b, err := ZipBytes(data, "test.zip")
+	if err != nil {
+		t.Fatalf("ZipBytes: %v", err)
+	}
+	if err := b.Verify(); err != nil {
+		t.Fatalf("Verify: %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "data.zip")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	zb, err := Open(path)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer zb.Close()
+
+	f, err := zb.Open("sentence-boundary/weights.csv")
+	if err != nil {
+		t.Fatalf("Open file: %v", err)
+	}
+	defer f.Close()
+	content, err := io.ReadAll(f)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+	if string(content) != "a\t1.0\n" {
+		t.Errorf("unexpected content %q", content)
+	}
+}
+
+func TestDirBundleAndFromEnv(t *testing.T) {
+	root := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(root, "normalization"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "normalization", "split.txt"), []byte("x=y\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv(EnvDataRoot, root)
+	b, err := FromEnv()
+	if err != nil {
+		t.Fatalf("FromEnv: %v", err)
+	}
+	if !b.Exists("normalization/split.txt") {
+		t.Error("expected normalization/split.txt to exist")
+	}
+
+	if _, err := Dir(filepath.Join(root, "missing")); err == nil {
+		t.Error("expected error for missing directory")
+	}
+}
@@ -0,0 +1,98 @@
+package resource
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"sort"
+)
+
+// ManifestName is the file name a bundle manifest is read from
+const ManifestName = "manifest.json"
+
+// Manifest describes the files of a resource bundle and their checksums
+type Manifest struct {
+	Name    string               `json:"name,omitempty"`
+	Version string               `json:"version,omitempty"`
+	Files   map[string]FileEntry `json:"files"`
+}
+
+// FileEntry holds the expected size and SHA-256 digest of a bundle file
+type FileEntry struct {
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// ReadManifest decodes a manifest from r
+func ReadManifest(r io.Reader) (*Manifest, error) {
+	m := &Manifest{}
+	if err := json.NewDecoder(r).Decode(m); err != nil {
+		return nil, fmt.Errorf("resource: decode manifest: %w", err)
+	}
+	if m.Files == nil {
+		m.Files = make(map[string]FileEntry)
+	}
+	for name := range m.Files {
+		if !fs.ValidPath(name) {
+			return nil, fmt.Errorf("resource: invalid path in manifest: %q", name)
+		}
+	}
+	return m, nil
+}
+
+// Write encodes the manifest as indented JSON
+func (m *Manifest) Write(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(m)
+}
+
+// FileNames returns the manifest file names in sorted order
+func (m *Manifest) FileNames() []string {
+	names := make([]string, 0, len(m.Files))
+	for name := range m.Files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// GenerateManifest walks fsys and records the size and checksum of every
+// regular file except the manifest itself
+func GenerateManifest(fsys fs.FS, name, version string) (*Manifest, error) {
+	m := &Manifest{
+		Name:    name,
+		Version: version,
+		Files:   make(map[string]FileEntry),
+	}
+
+	err := fs.WalkDir(fsys, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || path == ManifestName {
+			return nil
+		}
+		f, err := fsys.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		h := sha256.New()
+		size, err := io.Copy(h, f)
+		if err != nil {
+			return err
+		}
+		m.Files[path] = FileEntry{Size: size, SHA256: hex.EncodeToString(h.Sum(nil))}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
@@ -1,17 +1,29 @@
 package turkish
 
+import (
+	"strings"
+	"unicode"
+)
+
 // TurkishSyllableExtractor handles syllable extraction for Turkish
+//
+// Syllables have one vowel each. A single consonant between two vowels starts
+// the next syllable (ka-pı), of two or three consonants only the last one
+// does (kap-ta, Türk-çe). The strict extractor accepts only words that follow
+// these rules. The lenient one also syllabifies loanwords: consonant
+// clusters at the start and end of a word stay in the first and last
+// syllable (tren, sport) and of four or more consonants between vowels the
+// first two end a syllable (eks-tra).
 type TurkishSyllableExtractor struct {
 	Alphabet *TurkishAlphabet
 	Strict   bool
 }
 
-// Strict syllable extractor instance
-var Strict *TurkishSyllableExtractor
-
-func init() {
-	Strict = NewTurkishSyllableExtractor(true)
-}
+// Syllable extractor instances
+var (
+	Strict  = NewTurkishSyllableExtractor(true)
+	Lenient = NewTurkishSyllableExtractor(false)
+)
 
 // NewTurkishSyllableExtractor creates a new syllable extractor
 func NewTurkishSyllableExtractor(strict bool) *TurkishSyllableExtractor {
@@ -20,3 +32,234 @@ func NewTurkishSyllableExtractor(strict bool) *TurkishSyllableExtractor {
 		Strict:   strict,
 	}
 }
+
+// GetSyllables returns the syllables of word, keeping its case. Returns nil
+// if word contains a non letter or, in strict mode, can not be syllabified.
+func (se *TurkishSyllableExtractor) GetSyllables(word string) []string {
+	runes := []rune(word)
+	boundaries := se.SyllableBoundaries(word)
+	if boundaries == nil {
+		return nil
+	}
+	syllables := make([]string, 0, len(boundaries)+1)
+	start := 0
+	for _, b := range boundaries {
+		syllables = append(syllables, string(runes[start:b]))
+		start = b
+	}
+	return append(syllables, string(runes[start:]))
+}
+
+// SyllableCount returns the number of syllables of word, 0 if it can not be
+// syllabified
+func (se *TurkishSyllableExtractor) SyllableCount(word string) int {
+	boundaries := se.SyllableBoundaries(word)
+	if boundaries == nil {
+		return 0
+	}
+	return len(boundaries) + 1
+}
+
+// SyllableBoundaries returns the rune indexes where the second and later
+// syllables of word start. A word of one syllable gives an empty slice, a
+// word that can not be syllabified gives nil. The lenient extractor reads a
+// word without vowels, like an abbreviation, as a single syllable.
+func (se *TurkishSyllableExtractor) SyllableBoundaries(word string) []int {
+	runes := []rune(word)
+	if len(runes) == 0 {
+		return nil
+	}
+	var vowels []int
+	for i, r := range runes {
+		if !unicode.IsLetter(r) {
+			return nil
+		}
+		if se.Alphabet.IsVowel(r) {
+			vowels = append(vowels, i)
+		}
+	}
+	if len(vowels) == 0 {
+		if se.Strict {
+			return nil
+		}
+		return []int{}
+	}
+	if se.Strict && (vowels[0] > 1 || len(runes)-1-vowels[len(vowels)-1] > 2) {
+		return nil
+	}
+
+	boundaries := make([]int, 0, len(vowels)-1)
+	for i := 0; i+1 < len(vowels); i++ {
+		consonants := vowels[i+1] - vowels[i] - 1
+		switch {
+		case consonants <= 1:
+			boundaries = append(boundaries, vowels[i+1]-consonants)
+		case consonants <= 3:
+			boundaries = append(boundaries, vowels[i+1]-1)
+		case se.Strict:
+			return nil
+		default:
+			boundaries = append(boundaries, vowels[i]+3)
+		}
+	}
+	return boundaries
+}
+
+// SoftHyphen is the invisible hyphen that marks a line break opportunity
+const SoftHyphen = "\u00ad"
+
+// Hyphenator finds hyphenation points of Turkish words at syllable
+// boundaries.
+//
+// Apostrophes do not take part in syllabification and stay at the end of
+// the line (Ankara'-ya). Words joined with a hyphen are only hyphenated
+// within their parts. Closed compounds listed in Compounds are broken between
+// their parts first, so that syllables do not run across them.
+type Hyphenator struct {
+	Extractor *TurkishSyllableExtractor
+	// MinPrefix and MinSuffix are the minimum number of letters before the
+	// first and after the last hyphenation point of a word part
+	MinPrefix int
+	MinSuffix int
+	// Hyphen is inserted by Hyphenate, SoftHyphen by default
+	Hyphen string
+	// Compounds maps lower case compound words to their parts, e.g.
+	// "hanımeli" to "hanım", "eli". Inflected forms of the words match too.
+	Compounds map[string][]string
+}
+
+// NewHyphenator creates a lenient hyphenator that inserts soft hyphens and
+// keeps at least two letters on both sides of a break
+func NewHyphenator() *Hyphenator {
+	return &Hyphenator{
+		Extractor: Lenient,
+		MinPrefix: 2,
+		MinSuffix: 2,
+		Hyphen:    SoftHyphen,
+		Compounds: make(map[string][]string),
+	}
+}
+
+// AddCompound registers a compound word by its parts
+func (h *Hyphenator) AddCompound(parts ...string) {
+	if h.Compounds == nil {
+		h.Compounds = make(map[string][]string)
+	}
+	lower := make([]string, len(parts))
+	for i, p := range parts {
+		lower[i] = h.Extractor.Alphabet.ToLower(p)
+	}
+	h.Compounds[strings.Join(lower, "")] = lower
+}
+
+// HyphenPoints returns the rune indexes of word where a hyphen can be
+// inserted. Existing hyphens are not included.
+func (h *Hyphenator) HyphenPoints(word string) []int {
+	runes := []rune(word)
+	var points []int
+	start := 0
+	for i := 0; i <= len(runes); i++ {
+		if i == len(runes) || runes[i] == '-' {
+			points = append(points, h.partPoints(runes[start:i], start)...)
+			start = i + 1
+		}
+	}
+	return points
+}
+
+// partPoints returns the hyphenation points of a word part without hyphens,
+// shifted by offset
+func (h *Hyphenator) partPoints(part []rune, offset int) []int {
+	// letters of the part and their positions, without apostrophes
+	letters := make([]rune, 0, len(part))
+	positions := make([]int, 0, len(part))
+	for i, r := range part {
+		if !h.Extractor.Alphabet.Apostrophe[r] {
+			letters = append(letters, r)
+			positions = append(positions, i)
+		}
+	}
+
+	var points []int
+	start := 0
+	for _, length := range h.compoundSplit(letters) {
+		end := start + length
+		boundaries := h.Extractor.SyllableBoundaries(string(letters[start:end]))
+		if start > 0 {
+			boundaries = append([]int{0}, boundaries...)
+		}
+		for _, b := range boundaries {
+			b += start
+			if b >= h.MinPrefix && len(letters)-b >= h.MinSuffix {
+				points = append(points, offset+positions[b])
+			}
+		}
+		start = end
+	}
+	return points
+}
+
+// compoundSplit returns the lengths of the compound parts of letters. The
+// longest registered compound that starts the word is used and the rest of
+// the word belongs to its last part.
+func (h *Hyphenator) compoundSplit(letters []rune) []int {
+	lower := []rune(h.Extractor.Alphabet.ToLower(string(letters)))
+	var best []string
+	bestLength := 0
+	for compound, parts := range h.Compounds {
+		c := []rune(compound)
+		if len(c) > bestLength && len(c) <= len(lower) && string(lower[:len(c)]) == compound {
+			best, bestLength = parts, len(c)
+		}
+	}
+	if best == nil {
+		return []int{len(letters)}
+	}
+	lengths := make([]int, len(best))
+	for i, p := range best {
+		lengths[i] = len([]rune(p))
+	}
+	lengths[len(lengths)-1] += len(letters) - bestLength
+	return lengths
+}
+
+// Hyphenate inserts the hyphen at the hyphenation points of word
+func (h *Hyphenator) Hyphenate(word string) string {
+	points := h.HyphenPoints(word)
+	if len(points) == 0 {
+		return word
+	}
+	runes := []rune(word)
+	var sb strings.Builder
+	last := 0
+	for _, p := range points {
+		sb.WriteString(string(runes[last:p]))
+		sb.WriteString(h.Hyphen)
+		last = p
+	}
+	sb.WriteString(string(runes[last:]))
+	return sb.String()
+}
+
+// HyphenateText hyphenates the words of text, leaving other characters as
+// they are
+func (h *Hyphenator) HyphenateText(text string) string {
+	var sb strings.Builder
+	var word strings.Builder
+	flush := func() {
+		if word.Len() > 0 {
+			sb.WriteString(h.Hyphenate(word.String()))
+			word.Reset()
+		}
+	}
+	for _, r := range text {
+		if unicode.IsLetter(r) || ((r == '-' || h.Extractor.Alphabet.Apostrophe[r]) && word.Len() > 0) {
+			word.WriteRune(r)
+			continue
+		}
+		flush()
+		sb.WriteRune(r)
+	}
+	flush()
+	return sb.String()
+}
@@ -0,0 +1,90 @@
+package turkish
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGetSyllables(t *testing.T) {
+	tests := []struct {
+		word    string
+		strict  string
+		lenient string
+	}{
+		{"kapı", "ka-pı", "ka-pı"},
+		{"saat", "sa-at", "sa-at"},
+		{"kaptan", "kap-tan", "kap-tan"},
+		{"Türkçe", "Türk-çe", "Türk-çe"},
+		{"kontrol", "kont-rol", "kont-rol"},
+		{"İstanbul", "İs-tan-bul", "İs-tan-bul"},
+		{"a", "a", "a"},
+		{"tren", "", "tren"},
+		{"sport", "", "sport"},
+		{"ekstra", "", "eks-tra"},
+		{"TBMM", "", "TBMM"},
+		{"3G", "", ""},
+	}
+	for _, tt := range tests {
+		if got := strings.Join(Strict.GetSyllables(tt.word), "-"); got != tt.strict {
+			t.Errorf("strict %s = %q, want %q", tt.word, got, tt.strict)
+		}
+		if got := strings.Join(Lenient.GetSyllables(tt.word), "-"); got != tt.lenient {
+			t.Errorf("lenient %s = %q, want %q", tt.word, got, tt.lenient)
+		}
+	}
+	if n := Lenient.SyllableCount("kitaplarımızdan"); n != 6 {
+		t.Errorf("SyllableCount = %d, want 6", n)
+	}
+}
+
+func TestHyphenate(t *testing.T) {
+	h := NewHyphenator()
+	h.Hyphen = "-"
+	tests := []struct {
+		word string
+		want string
+	}{
+		{"kitaplarımızdan", "ki-tap-la-rı-mız-dan"},
+		{"araba", "ara-ba"},
+		{"ev", "ev"},
+		{"Ankara'ya", "An-ka-ra'-ya"},
+		{"Türk-Yunan", "Türk-Yu-nan"},
+		{"ekstra", "eks-tra"},
+	}
+	for _, tt := range tests {
+		if got := h.Hyphenate(tt.word); got != tt.want {
+			t.Errorf("Hyphenate(%s) = %q, want %q", tt.word, got, tt.want)
+		}
+	}
+
+	h.MinPrefix, h.MinSuffix = 4, 4
+	if got := h.Hyphenate("kitaplarımızdan"); got != "kitap-la-rı-mızdan" {
+		t.Errorf("min lengths: %q", got)
+	}
+}
+
+func TestHyphenateCompounds(t *testing.T) {
+	h := NewHyphenator()
+	h.Hyphen = "-"
+	if got := h.Hyphenate("hanımeli"); got != "ha-nı-me-li" {
+		t.Errorf("without compound: %q", got)
+	}
+	h.AddCompound("hanım", "eli")
+	if got := h.Hyphenate("hanımeline"); got != "ha-nım-e-li-ne" {
+		t.Errorf("with compound: %q", got)
+	}
+	if got := h.HyphenPoints("Hanımeli"); !reflect.DeepEqual(got, []int{2, 5, 6}) {
+		t.Errorf("HyphenPoints = %v", got)
+	}
+}
+
+func TestHyphenateText(t *testing.T) {
+	h := NewHyphenator()
+	got := h.HyphenateText("Kitabı masaya, (Ankara'da) koydu.")
+	want := "Ki" + SoftHyphen + "ta" + SoftHyphen + "bı ma" + SoftHyphen + "sa" + SoftHyphen + "ya, (An" +
+		SoftHyphen + "ka" + SoftHyphen + "ra'" + SoftHyphen + "da) koy" + SoftHyphen + "du."
+	if got != want {
+		t.Errorf("HyphenateText = %q, want %q", got, want)
+	}
+}
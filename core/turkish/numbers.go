@@ -0,0 +1,589 @@
+package turkish
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var (
+	numberOnes   = []string{"", "bir", "iki", "üç", "dört", "beş", "altı", "yedi", "sekiz", "dokuz"}
+	numberTens   = []string{"", "on", "yirmi", "otuz", "kırk", "elli", "altmış", "yetmiş", "seksen", "doksan"}
+	numberScales = []string{"", "bin", "milyon", "milyar", "trilyon", "katrilyon", "kentilyon"}
+)
+
+// number word kinds
+const (
+	numberOne = iota
+	numberTen
+	numberHundred
+	numberScale
+	numberZero
+)
+
+type numberWord struct {
+	kind    int
+	value   int64
+	ordinal bool
+}
+
+// numberWords maps cardinal and ordinal number words to their values
+var numberWords = map[string]numberWord{}
+
+func init() {
+	add := func(word string, kind int, value int64) {
+		numberWords[word] = numberWord{kind: kind, value: value}
+		numberWords[ordinalWord(word)] = numberWord{kind: kind, value: value, ordinal: true}
+	}
+	for i := 1; i < 10; i++ {
+		add(numberOnes[i], numberOne, int64(i))
+		add(numberTens[i], numberTen, int64(i*10))
+	}
+	add("yüz", numberHundred, 100)
+	scale := int64(1)
+	for _, s := range numberScales[1:] {
+		scale *= 1000
+		add(s, numberScale, scale)
+	}
+	add("sıfır", numberZero, 0)
+}
+
+// ordinalWord returns the ordinal of a single number word, e.g. "üç" →
+// "üçüncü"
+func ordinalWord(word string) string {
+	if word == "dört" {
+		return "dördüncü"
+	}
+	runes := []rune(word)
+	var vowel rune
+	for i := len(runes) - 1; i >= 0; i-- {
+		if Instance.IsVowel(runes[i]) {
+			switch runes[i] {
+			case 'a', 'ı':
+				vowel = 'ı'
+			case 'e', 'i':
+				vowel = 'i'
+			case 'o', 'u':
+				vowel = 'u'
+			default:
+				vowel = 'ü'
+			}
+			break
+		}
+	}
+	suffix := "nc" + string(vowel)
+	if !Instance.IsVowel(runes[len(runes)-1]) {
+		suffix = string(vowel) + suffix
+	}
+	return word + suffix
+}
+
+// NumberToWords returns n in words, e.g. 1234 → "bin iki yüz otuz dört"
+func NumberToWords(n int64) string {
+	if n == 0 {
+		return "sıfır"
+	}
+	if n < 0 {
+		// negating math.MinInt64 overflows, uint64 does not
+		return "eksi " + unsignedToWords(uint64(-(n+1))+1)
+	}
+	return unsignedToWords(uint64(n))
+}
+
+func unsignedToWords(n uint64) string {
+	var groups []string
+	for scale := 0; n > 0; scale++ {
+		group := int(n % 1000)
+		n /= 1000
+		if group == 0 {
+			continue
+		}
+		var words []string
+		if scale != 1 || group != 1 {
+			words = append(words, hundredsToWords(group))
+		}
+		if scale > 0 {
+			words = append(words, numberScales[scale])
+		}
+		groups = append([]string{strings.Join(words, " ")}, groups...)
+	}
+	return strings.Join(groups, " ")
+}
+
+// hundredsToWords reads a number between 1 and 999
+func hundredsToWords(n int) string {
+	var words []string
+	if h := n / 100; h > 0 {
+		if h > 1 {
+			words = append(words, numberOnes[h])
+		}
+		words = append(words, "yüz")
+	}
+	if t := n / 10 % 10; t > 0 {
+		words = append(words, numberTens[t])
+	}
+	if o := n % 10; o > 0 {
+		words = append(words, numberOnes[o])
+	}
+	return strings.Join(words, " ")
+}
+
+// OrdinalToWords returns the ordinal of n in words, e.g. 3 → "üçüncü"
+func OrdinalToWords(n int64) string {
+	words := NumberToWords(n)
+	i := strings.LastIndexByte(words, ' ')
+	return words[:i+1] + ordinalWord(words[i+1:])
+}
+
+// WordsToNumber parses a cardinal number in words. Words may be written
+// separately or joined ("bin iki yüz otuz dört", "binikiyüzotuzdört") and in
+// any case; "eksi" makes the number negative. Only the canonical wording is
+// accepted: "bir bin" and "bir yüz" are errors.
+func WordsToNumber(s string) (int64, error) {
+	n, ordinal, err := parseNumberWords(s)
+	if err == nil && ordinal {
+		err = fmt.Errorf("turkish: %q is an ordinal", s)
+	}
+	return n, err
+}
+
+// WordsToOrdinal parses an ordinal number in words, e.g. "yüz yirmi
+// üçüncü" → 123
+func WordsToOrdinal(s string) (int64, error) {
+	n, ordinal, err := parseNumberWords(s)
+	if err == nil && !ordinal {
+		err = fmt.Errorf("turkish: %q is not an ordinal", s)
+	}
+	return n, err
+}
+
+func parseNumberWords(s string) (int64, bool, error) {
+	tokens, err := splitNumberWords(s)
+	if err != nil {
+		return 0, false, err
+	}
+	negative := len(tokens) > 0 && tokens[0] == "eksi"
+	if negative {
+		tokens = tokens[1:]
+	}
+	if len(tokens) == 0 {
+		return 0, false, fmt.Errorf("turkish: no number in %q", s)
+	}
+
+	var total, current int64
+	lastScale := int64(math.MaxInt64)
+	ordinal := false
+	for i, t := range tokens {
+		w := numberWords[t]
+		if ordinal {
+			return 0, false, fmt.Errorf("turkish: %q: ordinal %q is not the last word", s, tokens[i-1])
+		}
+		ordinal = w.ordinal
+		switch w.kind {
+		case numberZero:
+			if len(tokens) > 1 {
+				return 0, false, fmt.Errorf("turkish: %q: unexpected %q", s, t)
+			}
+		case numberOne:
+			if current%10 != 0 {
+				return 0, false, fmt.Errorf("turkish: %q: unexpected %q", s, t)
+			}
+			current += w.value
+		case numberTen:
+			if current%100 != 0 {
+				return 0, false, fmt.Errorf("turkish: %q: unexpected %q", s, t)
+			}
+			current += w.value
+		case numberHundred:
+			// "yüz" is not written "bir yüz"
+			if current >= 10 || current == 1 {
+				return 0, false, fmt.Errorf("turkish: %q: unexpected %q", s, t)
+			}
+			if current == 0 {
+				current = 1
+			}
+			current *= 100
+		case numberScale:
+			if w.value >= lastScale {
+				return 0, false, fmt.Errorf("turkish: %q: unexpected %q", s, t)
+			}
+			if current == 1 && w.value == 1000 {
+				// "bin" is not written "bir bin"
+				return 0, false, fmt.Errorf("turkish: %q: unexpected %q", s, tokens[i-1])
+			}
+			if current == 0 {
+				if w.value != 1000 {
+					return 0, false, fmt.Errorf("turkish: %q: %q without a number", s, t)
+				}
+				current = 1
+			}
+			if current > (math.MaxInt64-total)/w.value {
+				return 0, false, fmt.Errorf("turkish: %q: number too large", s)
+			}
+			total += current * w.value
+			current = 0
+			lastScale = w.value
+		}
+	}
+	total += current
+	if negative {
+		total = -total
+	}
+	return total, ordinal, nil
+}
+
+// splitNumberWords splits s into number words, separating joined words by
+// longest match
+func splitNumberWords(s string) ([]string, error) {
+	var tokens []string
+	for _, field := range strings.Fields(Instance.ToLower(s)) {
+		runes := []rune(field)
+		for len(runes) > 0 {
+			match := 0
+			for end := len(runes); end > 0; end-- {
+				w := string(runes[:end])
+				if _, ok := numberWords[w]; ok || (w == "eksi" && len(tokens) == 0) {
+					match = end
+					break
+				}
+			}
+			if match == 0 {
+				return nil, fmt.Errorf("turkish: %q is not a number word", field)
+			}
+			tokens = append(tokens, string(runes[:match]))
+			runes = runes[match:]
+		}
+	}
+	return tokens, nil
+}
+
+// ReadNumber returns a number written with digits in words. Dots separate
+// thousands ("1.234"), a comma starts the fraction ("24,30" → "yirmi dört
+// virgül otuz"), a trailing dot makes an ordinal ("3." → "üçüncü") and "%"
+// a percentage ("%40" → "yüzde kırk"). A single "-" may come before the
+// "%" ("-%5" → "eksi yüzde beş").
+func ReadNumber(s string) (string, error) {
+	s = strings.TrimSpace(s)
+	prefix := ""
+	if rest, ok := strings.CutPrefix(s, "-"); ok {
+		prefix, s = "eksi ", rest
+	}
+	if rest, ok := strings.CutPrefix(s, "%"); ok {
+		prefix, s = prefix+"yüzde ", rest
+	}
+	words, err := readUnsignedNumber(s)
+	if err != nil {
+		return "", err
+	}
+	return prefix + words, nil
+}
+
+// readUnsignedNumber reads a number without sign or percent sign
+func readUnsignedNumber(s string) (string, error) {
+	if strings.HasSuffix(s, ".") && !strings.Contains(s, ",") {
+		n, err := parseDigits(s[:len(s)-1])
+		if err != nil {
+			return "", err
+		}
+		return OrdinalToWords(n), nil
+	}
+
+	integer, fraction, hasFraction := strings.Cut(s, ",")
+	n, err := parseDigits(integer)
+	if err != nil {
+		return "", err
+	}
+	words := NumberToWords(n)
+	if !hasFraction {
+		return words, nil
+	}
+	fractionWords, err := readFraction(fraction)
+	if err != nil {
+		return "", fmt.Errorf("turkish: %q: %w", s, err)
+	}
+	return words + " virgül " + fractionWords, nil
+}
+
+// parseDigits parses digits with optional dots between groups of three
+func parseDigits(s string) (int64, error) {
+	groups := strings.Split(s, ".")
+	for i, g := range groups {
+		if g == "" || (i > 0 && len(g) != 3) {
+			return 0, fmt.Errorf("turkish: %q is not a number", s)
+		}
+	}
+	digits := strings.Join(groups, "")
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return 0, fmt.Errorf("turkish: %q is not a number", s)
+		}
+	}
+	n, err := strconv.ParseInt(digits, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("turkish: %q: number too large", s)
+	}
+	return n, nil
+}
+
+// readFraction reads the digits after a decimal comma; leading zeros are
+// read one by one ("05" → "sıfır beş")
+func readFraction(s string) (string, error) {
+	if s == "" {
+		return "", errors.New("empty fraction")
+	}
+	var words []string
+	for len(s) > 1 && s[0] == '0' {
+		words = append(words, "sıfır")
+		s = s[1:]
+	}
+	n, err := parseDigits(s)
+	if err != nil {
+		return "", err
+	}
+	return strings.Join(append(words, NumberToWords(n)), " "), nil
+}
+
+// ParseNumberWords is the inverse of ReadNumber. It returns the digits of a
+// number in words without thousands separators, e.g. "yirmi dört virgül
+// otuz" → "24,30", "üçüncü" → "3." and "yüzde kırk" → "%40".
+func ParseNumberWords(s string) (string, error) {
+	lower := strings.TrimSpace(Instance.ToLower(s))
+	if rest, ok := strings.CutPrefix(lower, "eksi yüzde "); ok {
+		digits, err := ParseNumberWords("yüzde " + rest)
+		if err != nil {
+			return "", err
+		}
+		return "-" + digits, nil
+	}
+	if rest, ok := strings.CutPrefix(lower, "yüzde "); ok {
+		digits, err := ParseNumberWords(rest)
+		if err != nil {
+			return "", err
+		}
+		if strings.HasPrefix(digits, "-") {
+			return "", fmt.Errorf("turkish: %q: sign after yüzde", s)
+		}
+		return "%" + digits, nil
+	}
+
+	integer, fraction, hasFraction := strings.Cut(lower, " virgül ")
+	n, ordinal, err := parseNumberWords(integer)
+	if err != nil {
+		return "", err
+	}
+	digits := strconv.FormatInt(n, 10)
+	if ordinal {
+		if hasFraction {
+			return "", fmt.Errorf("turkish: %q: ordinal with fraction", s)
+		}
+		return digits + ".", nil
+	}
+	if !hasFraction {
+		return digits, nil
+	}
+
+	fields := strings.Fields(fraction)
+	zeros := 0
+	for zeros < len(fields)-1 && fields[zeros] == "sıfır" {
+		zeros++
+	}
+	f, err := WordsToNumber(strings.Join(fields[zeros:], " "))
+	if err != nil || f < 0 {
+		return "", fmt.Errorf("turkish: %q: invalid fraction", s)
+	}
+	return digits + "," + strings.Repeat("0", zeros) + strconv.FormatInt(f, 10), nil
+}
+
+// Currency describes how amounts of money are read
+type Currency struct {
+	Code string
+	// Symbols are written before or after amounts, e.g. "TL" and "₺"
+	Symbols []string
+	Unit    string
+	Subunit string
+}
+
+// Currencies
+var (
+	TurkishLira = &Currency{Code: "TRY", Symbols: []string{"TL", "₺", "TRY"}, Unit: "lira", Subunit: "kuruş"}
+	USDollar    = &Currency{Code: "USD", Symbols: []string{"$", "USD"}, Unit: "dolar", Subunit: "sent"}
+	Euro        = &Currency{Code: "EUR", Symbols: []string{"€", "EUR"}, Unit: "avro", Subunit: "sent"}
+)
+
+// Currencies are the currencies recognized by ReadAmount and WordsToAmount
+var Currencies = []*Currency{TurkishLira, USDollar, Euro}
+
+// AmountToWords reads an amount, e.g. 24 lira 30 kuruş → "yirmi dört lira
+// otuz kuruş". Zero units are omitted if there are subunits.
+func (c *Currency) AmountToWords(units int64, subunits int) string {
+	if subunits == 0 {
+		return NumberToWords(units) + " " + c.Unit
+	}
+	sub := NumberToWords(int64(subunits)) + " " + c.Subunit
+	if units == 0 {
+		return sub
+	}
+	return NumberToWords(units) + " " + c.Unit + " " + sub
+}
+
+// ParseAmount parses an amount written with digits and a currency symbol
+// before or after it, e.g. "24,30 TL", "₺1.250" or "$5,5". The fraction has
+// at most two digits, "5,5" is 5 units 50 subunits.
+func ParseAmount(s string) (units int64, subunits int, c *Currency, err error) {
+	s = strings.TrimSpace(s)
+	number := ""
+	for _, cur := range Currencies {
+		for _, symbol := range cur.Symbols {
+			if rest, ok := strings.CutSuffix(s, symbol); ok {
+				c, number = cur, rest
+			} else if rest, ok := strings.CutPrefix(s, symbol); ok {
+				c, number = cur, rest
+			}
+		}
+		if c != nil {
+			break
+		}
+	}
+	if c == nil {
+		return 0, 0, nil, fmt.Errorf("turkish: %q has no currency", s)
+	}
+
+	number = strings.TrimSpace(number)
+	integer, fraction, _ := strings.Cut(number, ",")
+	if units, err = parseDigits(integer); err != nil {
+		return 0, 0, nil, err
+	}
+	if len(fraction) > 2 || strings.Contains(number, ",") && fraction == "" {
+		return 0, 0, nil, fmt.Errorf("turkish: %q: invalid subunits", s)
+	}
+	for len(fraction) < 2 && fraction != "" {
+		fraction += "0"
+	}
+	if fraction != "" {
+		sub, err := parseDigits(fraction)
+		if err != nil {
+			return 0, 0, nil, err
+		}
+		subunits = int(sub)
+	}
+	return units, subunits, c, nil
+}
+
+// ReadAmount reads an amount written with digits, e.g. "24,30 TL" →
+// "yirmi dört lira otuz kuruş"
+func ReadAmount(s string) (string, error) {
+	units, subunits, c, err := ParseAmount(s)
+	if err != nil {
+		return "", err
+	}
+	return c.AmountToWords(units, subunits), nil
+}
+
+// WordsToAmount parses an amount in words, e.g. "yirmi dört lira otuz
+// kuruş" or "elli kuruş". A subunit alone that several currencies share, as
+// "sent" in "elli sent", is ambiguous and an error; use the WordsToAmount
+// method of the currency to read it.
+func WordsToAmount(s string) (units int64, subunits int, c *Currency, err error) {
+	return wordsToAmount(s, Currencies)
+}
+
+// WordsToAmount parses an amount of c in words, e.g. "elli sent" for
+// USDollar or Euro
+func (c *Currency) WordsToAmount(s string) (units int64, subunits int, err error) {
+	units, subunits, _, err = wordsToAmount(s, []*Currency{c})
+	return units, subunits, err
+}
+
+// wordsToAmount parses an amount in words in one of currencies
+func wordsToAmount(s string, currencies []*Currency) (units int64, subunits int, c *Currency, err error) {
+	fields := strings.Fields(Instance.ToLower(s))
+	unitAt, subunitAt := -1, -1
+	for i, f := range fields {
+		if unitAt < 0 {
+			for _, cur := range currencies {
+				if f == cur.Unit {
+					c, unitAt = cur, i
+				}
+			}
+			if unitAt >= 0 {
+				continue
+			}
+		}
+		var matches []*Currency
+		for _, cur := range currencies {
+			if f == cur.Subunit && (c == nil || c == cur) {
+				matches = append(matches, cur)
+			}
+		}
+		if len(matches) > 1 {
+			return 0, 0, nil, fmt.Errorf("turkish: %q: %q is the subunit of more than one currency", s, f)
+		}
+		if len(matches) == 1 {
+			c, subunitAt = matches[0], i
+			break
+		}
+	}
+	if c == nil {
+		return 0, 0, nil, fmt.Errorf("turkish: %q has no currency", s)
+	}
+
+	subStart := 0
+	if unitAt >= 0 {
+		if units, err = WordsToNumber(strings.Join(fields[:unitAt], " ")); err != nil {
+			return 0, 0, nil, err
+		}
+		subStart = unitAt + 1
+	}
+	if subunitAt >= 0 {
+		sub, err := WordsToNumber(strings.Join(fields[subStart:subunitAt], " "))
+		if err != nil {
+			return 0, 0, nil, err
+		}
+		if sub < 0 || sub > 99 {
+			return 0, 0, nil, fmt.Errorf("turkish: %q: invalid subunits", s)
+		}
+		subunits = int(sub)
+	}
+	last := unitAt
+	if subunitAt >= 0 {
+		last = subunitAt
+	}
+	if last != len(fields)-1 {
+		return 0, 0, nil, fmt.Errorf("turkish: %q: unexpected %q", s, fields[last+1])
+	}
+	return units, subunits, c, nil
+}
+
+// CheckAmount verifies that an amount written with digits and the same
+// amount in words agree, e.g. "24,30 TL" and "yirmi dört lira otuz kuruş".
+// Returns an error describing the first difference.
+func CheckAmount(amount, words string) error {
+	units, subunits, c, err := ParseAmount(amount)
+	if err != nil {
+		return err
+	}
+	wUnits, wSubunits, err := c.WordsToAmount(words)
+	if err != nil {
+		if _, _, wc, werr := WordsToAmount(words); werr == nil && wc != c {
+			return fmt.Errorf("turkish: currency %s does not match %s", c.Code, wc.Code)
+		}
+		return err
+	}
+	if units != wUnits || subunits != wSubunits {
+		return fmt.Errorf("turkish: %q is %q, not %q", amount, c.AmountToWords(units, subunits), words)
+	}
+	return nil
+}
+
+// IsNumber reports whether s is a number that ReadNumber can read
+func IsNumber(s string) bool {
+	digits := strings.TrimLeft(s, "%-")
+	if digits == "" || !unicode.IsDigit(rune(digits[0])) {
+		return false
+	}
+	_, err := ReadNumber(s)
+	return err == nil
+}
@@ -0,0 +1,162 @@
+package turkish
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNumberToWords(t *testing.T) {
+	tests := []struct {
+		n    int64
+		want string
+	}{
+		{0, "sıfır"},
+		{7, "yedi"},
+		{10, "on"},
+		{100, "yüz"},
+		{101, "yüz bir"},
+		{1000, "bin"},
+		{1234, "bin iki yüz otuz dört"},
+		{21000, "yirmi bir bin"},
+		{1001001, "bir milyon bin bir"},
+		{-45, "eksi kırk beş"},
+		{math.MinInt64, "eksi dokuz kentilyon iki yüz yirmi üç katrilyon üç yüz yetmiş iki trilyon otuz altı milyar sekiz yüz elli dört milyon yedi yüz yetmiş beş bin sekiz yüz sekiz"},
+	}
+	for _, tt := range tests {
+		got := NumberToWords(tt.n)
+		if got != tt.want {
+			t.Errorf("NumberToWords(%d) = %q, want %q", tt.n, got, tt.want)
+		}
+		if tt.n == math.MinInt64 {
+			continue
+		}
+		if n, err := WordsToNumber(got); err != nil || n != tt.n {
+			t.Errorf("WordsToNumber(%q) = %d, %v", got, n, err)
+		}
+	}
+}
+
+func TestOrdinals(t *testing.T) {
+	tests := []struct {
+		n    int64
+		want string
+	}{
+		{1, "birinci"},
+		{3, "üçüncü"},
+		{4, "dördüncü"},
+		{6, "altıncı"},
+		{10, "onuncu"},
+		{40, "kırkıncı"},
+		{100, "yüzüncü"},
+		{123, "yüz yirmi üçüncü"},
+		{1000, "bininci"},
+	}
+	for _, tt := range tests {
+		got := OrdinalToWords(tt.n)
+		if got != tt.want {
+			t.Errorf("OrdinalToWords(%d) = %q, want %q", tt.n, got, tt.want)
+		}
+		if n, err := WordsToOrdinal(got); err != nil || n != tt.n {
+			t.Errorf("WordsToOrdinal(%q) = %d, %v", got, n, err)
+		}
+	}
+	if _, err := WordsToNumber("üçüncü"); err == nil {
+		t.Error("ordinal accepted as cardinal")
+	}
+	if _, err := WordsToOrdinal("birinci iki"); err == nil {
+		t.Error("ordinal accepted before the last word")
+	}
+}
+
+func TestWordsToNumberErrors(t *testing.T) {
+	if n, err := WordsToNumber("BinİkiYüzOtuzDört"); err != nil || n != 1234 {
+		t.Errorf("joined words: %d, %v", n, err)
+	}
+	for _, s := range []string{"", "iki üç", "on yirmi", "bin milyon", "kitap", "milyon", "sıfır bir", "bir bin", "bir yüz", "iki bin bir yüz"} {
+		if n, err := WordsToNumber(s); err == nil {
+			t.Errorf("WordsToNumber(%q) = %d, expected error", s, n)
+		}
+	}
+}
+
+func TestReadNumber(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{"1234", "bin iki yüz otuz dört"},
+		{"1.234", "bin iki yüz otuz dört"},
+		{"3.", "üçüncü"},
+		{"24,30", "yirmi dört virgül otuz"},
+		{"2,05", "iki virgül sıfır beş"},
+		{"%40", "yüzde kırk"},
+		{"-5", "eksi beş"},
+		{"-%5", "eksi yüzde beş"},
+	}
+	for _, tt := range tests {
+		got, err := ReadNumber(tt.s)
+		if err != nil || got != tt.want {
+			t.Errorf("ReadNumber(%q) = %q, %v, want %q", tt.s, got, err, tt.want)
+			continue
+		}
+		digits, err := ParseNumberWords(got)
+		if err != nil {
+			t.Errorf("ParseNumberWords(%q): %v", got, err)
+		}
+		if want, _ := ReadNumber(digits); want != got {
+			t.Errorf("ParseNumberWords(%q) = %q", got, digits)
+		}
+	}
+	for _, s := range []string{"", "12a", "1.23", "3,", "%", "--5", "%%5", "%-5", "%-%5", "-"} {
+		if _, err := ReadNumber(s); err == nil {
+			t.Errorf("ReadNumber(%q) expected error", s)
+		}
+		if IsNumber(s) {
+			t.Errorf("IsNumber(%q)", s)
+		}
+	}
+}
+
+func TestAmounts(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{"24,30 TL", "yirmi dört lira otuz kuruş"},
+		{"₺1.250", "bin iki yüz elli lira"},
+		{"0,50 TL", "elli kuruş"},
+		{"$5,5", "beş dolar elli sent"},
+		{"10 €", "on avro"},
+		{"0,50 €", "elli sent"},
+	}
+	for _, tt := range tests {
+		got, err := ReadAmount(tt.s)
+		if err != nil || got != tt.want {
+			t.Errorf("ReadAmount(%q) = %q, %v, want %q", tt.s, got, err, tt.want)
+		}
+		if err := CheckAmount(tt.s, tt.want); err != nil {
+			t.Errorf("CheckAmount(%q, %q): %v", tt.s, tt.want, err)
+		}
+	}
+	if err := CheckAmount("24,30 TL", "yirmi dört lira üç kuruş"); err == nil {
+		t.Error("CheckAmount accepted different subunits")
+	}
+	if err := CheckAmount("24 TL", "yirmi dört dolar"); err == nil {
+		t.Error("CheckAmount accepted a different currency")
+	}
+	if err := CheckAmount("1.000 TL", "bir bin lira"); err == nil {
+		t.Error("CheckAmount accepted \"bir bin\"")
+	}
+	if _, _, _, err := WordsToAmount("elli sent"); err == nil {
+		t.Error("WordsToAmount accepted a subunit of two currencies")
+	}
+	if units, subunits, err := Euro.WordsToAmount("elli sent"); err != nil || units != 0 || subunits != 50 {
+		t.Errorf("Euro.WordsToAmount(\"elli sent\") = %d, %d, %v", units, subunits, err)
+	}
+	if err := CheckAmount("0,50 TL", "elli sent"); err == nil {
+		t.Error("CheckAmount accepted sent for lira")
+	}
+	if _, err := ReadAmount("24,305 TL"); err == nil {
+		t.Error("ReadAmount accepted three digit subunits")
+	}
+}
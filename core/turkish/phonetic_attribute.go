@@ -49,3 +49,40 @@ var phoneticAttributeStrings = map[PhoneticAttribute]string{
 func (p PhoneticAttribute) GetStringForm() string {
 	return phoneticAttributeStrings[p]
 }
+
+var phoneticAttributeNames = map[PhoneticAttribute]string{
+	LastLetterVowel:         "LastLetterVowel",
+	LastLetterConsonant:     "LastLetterConsonant",
+	LastVowelFrontal:        "LastVowelFrontal",
+	LastVowelBack:           "LastVowelBack",
+	LastVowelRounded:        "LastVowelRounded",
+	LastVowelUnrounded:      "LastVowelUnrounded",
+	LastLetterVoiceless:     "LastLetterVoiceless",
+	LastLetterVoiced:        "LastLetterVoiced",
+	LastLetterVoicelessStop: "LastLetterVoicelessStop",
+	FirstLetterVowel:        "FirstLetterVowel",
+	FirstLetterConsonant:    "FirstLetterConsonant",
+	HasNoVowel:              "HasNoVowel",
+	ExpectsVowel:            "ExpectsVowel",
+	ExpectsConsonant:        "ExpectsConsonant",
+	ModifiedPronoun:         "ModifiedPronoun",
+	UnModifiedPronoun:       "UnModifiedPronoun",
+	LastLetterDropped:       "LastLetterDropped",
+	CannotTerminate:         "CannotTerminate",
+}
+
+// Name returns the full name of the attribute, e.g. LastLetterVowel
+func (p PhoneticAttribute) Name() string {
+	return phoneticAttributeNames[p]
+}
+
+// PhoneticAttributeFromString parses a phonetic attribute from its full name
+// (LastLetterVowel) or its short form (LLV)
+func PhoneticAttributeFromString(s string) (PhoneticAttribute, bool) {
+	for attr, name := range phoneticAttributeNames {
+		if name == s || phoneticAttributeStrings[attr] == s {
+			return attr, true
+		}
+	}
+	return 0, false
+}
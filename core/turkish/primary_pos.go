@@ -41,3 +41,36 @@ var primaryPosStrings = map[PrimaryPos]string{
 func (p PrimaryPos) GetStringForm() string {
 	return primaryPosStrings[p]
 }
+
+var primaryPosNames = map[PrimaryPos]string{
+	Noun:         "Noun",
+	Adjective:    "Adjective",
+	Adverb:       "Adverb",
+	Conjunction:  "Conjunction",
+	Interjection: "Interjection",
+	Verb:         "Verb",
+	Pronoun:      "Pronoun",
+	Numeral:      "Numeral",
+	Determiner:   "Determiner",
+	PostPositive: "PostPositive",
+	Question:     "Question",
+	Duplicator:   "Duplicator",
+	Punctuation:  "Punctuation",
+	UnknownPos:   "Unknown",
+}
+
+// Name returns the full name of the POS tag, e.g. Adjective
+func (p PrimaryPos) Name() string {
+	return primaryPosNames[p]
+}
+
+// PrimaryPosFromString parses a POS tag from its short form (Adj) or its
+// full name (Adjective)
+func PrimaryPosFromString(s string) (PrimaryPos, bool) {
+	for pos, short := range primaryPosStrings {
+		if short == s || primaryPosNames[pos] == s {
+			return pos, true
+		}
+	}
+	return UnknownPos, false
+}
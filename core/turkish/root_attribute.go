@@ -1,5 +1,7 @@
 package turkish
 
+import "strings"
+
 // RootAttribute represents attributes of a root
 type RootAttribute int
 
@@ -73,3 +75,15 @@ var rootAttributeNames = map[RootAttribute]string{
 func (r RootAttribute) GetStringForm() string {
 	return rootAttributeNames[r]
 }
+
+// RootAttributeFromString parses a root attribute from its name. Both the
+// lexicon form (Aorist_I) and the form without underscores (AoristI) are
+// accepted.
+func RootAttributeFromString(s string) (RootAttribute, bool) {
+	for attr, name := range rootAttributeNames {
+		if name == s || strings.ReplaceAll(name, "_", "") == s {
+			return attr, true
+		}
+	}
+	return 0, false
+}
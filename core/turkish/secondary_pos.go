@@ -77,3 +77,14 @@ var secondaryPosStrings = map[SecondaryPos]string{
 func (s SecondaryPos) GetStringForm() string {
 	return secondaryPosStrings[s]
 }
+
+// SecondaryPosFromString parses a secondary POS tag from its short form
+// (Prop)
+func SecondaryPosFromString(s string) (SecondaryPos, bool) {
+	for pos, short := range secondaryPosStrings {
+		if short == s {
+			return pos, true
+		}
+	}
+	return UnknownSec, false
+}
@@ -42,11 +42,7 @@ type TurkishAlphabet struct {
 	ForeignDiacriticsMap map[rune]rune
 }
 
-var Instance *TurkishAlphabet
-
-func init() {
-	Instance = NewTurkishAlphabet()
-}
+var Instance = NewTurkishAlphabet()
 
 // NewTurkishAlphabet creates a new TurkishAlphabet instance
 func NewTurkishAlphabet() *TurkishAlphabet {
@@ -0,0 +1,31 @@
+//go:build demo
+// +build demo
+
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/kalaomer/zemberek-go/morphology"
+)
+
+// Writes the morphotactics graph to morphotactics.dot. Render it with
+//
+//	dot -Tsvg morphotactics.dot -o morphotactics.svg
+func main() {
+	morph := morphology.CreateWithDefaults()
+
+	f, err := os.Create("morphotactics.dot")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	defer f.Close()
+
+	if err := morph.Morphotactics.WriteDOT(f, nil); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	fmt.Println("Graph written to morphotactics.dot")
+}
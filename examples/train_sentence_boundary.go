@@ -0,0 +1,75 @@
+//go:build demo
+// +build demo
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/kalaomer/zemberek-go/tokenization"
+)
+
+// Trains sentence boundary weights on text with one sentence per line and
+// paragraphs separated by empty lines, then reports precision and recall on
+// a held-out file in the same format:
+//
+//	go run -tags demo examples/train_sentence_boundary.go \
+//	    -train train.txt -test test.txt -out weights.csv
+//
+// Load the weights with tokenization.NewTurkishSentenceExtractor(false, "weights.csv").
+func main() {
+	trainPath := flag.String("train", "", "training sentences")
+	testPath := flag.String("test", "", "held-out sentences to evaluate on")
+	outPath := flag.String("out", "sentence-boundary-weights.csv", "weights output")
+	epochs := flag.Int("epochs", tokenization.DefaultBoundaryEpochs, "passes over the training data")
+	quotes := flag.Bool("quotes", false, "do not split inside double quotes")
+	flag.Parse()
+
+	if *trainPath == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err := run(*trainPath, *testPath, *outPath, *epochs, *quotes); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(trainPath, testPath, outPath string, epochs int, quotes bool) error {
+	extractor, err := tokenization.NewTurkishSentenceExtractor(quotes, "")
+	if err != nil {
+		return err
+	}
+
+	train, err := os.Open(trainPath)
+	if err != nil {
+		return err
+	}
+	defer train.Close()
+	weights, err := extractor.Train(train, epochs)
+	if err != nil {
+		return err
+	}
+	if err := tokenization.SaveWeightsToCSV(outPath, weights); err != nil {
+		return err
+	}
+	fmt.Printf("%d weights written to %s\n", len(weights), outPath)
+
+	if testPath == "" {
+		return nil
+	}
+	test, err := os.Open(testPath)
+	if err != nil {
+		return err
+	}
+	defer test.Close()
+	extractor.Weights = weights
+	evaluation, err := extractor.Evaluate(test)
+	if err != nil {
+		return err
+	}
+	fmt.Println(evaluation)
+	return nil
+}
@@ -5,6 +5,6 @@ go 1.24.0
 toolchain go1.24.7
 
 require (
-	golang.org/x/text v0.29.0 // indirect
-	google.golang.org/protobuf v1.36.10 // indirect
+	golang.org/x/text v0.29.0
+	google.golang.org/protobuf v1.36.10
 )
@@ -1,8 +1,18 @@
 package lm
 
 import (
+	"errors"
 	"fmt"
+	"io/fs"
 	"math"
+	"path"
+)
+
+// Default model file names inside a resource bundle. Models are looked up at
+// the bundle root first and then under the "lm" directory.
+const (
+	DefaultBigramName  = "lm.2gram.slm"
+	DefaultUnigramName = "lm-unigram.slm"
 )
 
 // Vocabulary is an alias for LmVocabulary
@@ -94,3 +104,32 @@ func LoadFromFile(path string) (LanguageModel, error) {
 		return nil, fmt.Errorf("%v; unigram: %w", smoothErr, uniErr)
 	}
 }
+
+// LoadFromFS loads a language model from fsys. If name is empty the default
+// bigram model is searched at the root and in the "lm" directory; when no
+// bigram model is present the unigram model next to it is used instead.
+// Unlike the path based loaders nothing falls back to a placeholder model: a
+// missing model is an error.
+func LoadFromFS(fsys fs.FS, name string) (LanguageModel, error) {
+	candidates := []string{name}
+	if name == "" {
+		candidates = []string{DefaultBigramName, path.Join("lm", DefaultBigramName)}
+	}
+
+	var errs []error
+	for _, candidate := range candidates {
+		lm, err := LoadSmoothLMFS(fsys, candidate)
+		if err == nil {
+			return lm, nil
+		}
+		errs = append(errs, fmt.Errorf("smoothlm %s: %w", candidate, err))
+
+		unigram := path.Join(path.Dir(candidate), DefaultUnigramName)
+		uni, err := LoadUnigramFS(fsys, unigram)
+		if err == nil {
+			return uni, nil
+		}
+		errs = append(errs, fmt.Errorf("unigram %s: %w", unigram, err))
+	}
+	return nil, fmt.Errorf("lm: no language model found: %w", errors.Join(errs...))
+}
@@ -4,6 +4,8 @@ import (
 	"bufio"
 	"encoding/binary"
 	"fmt"
+	"io"
+	"io/fs"
 	"math"
 	"os"
 	"path/filepath"
@@ -41,7 +43,23 @@ func LoadSmoothLM(path string) (*SmoothLM, error) {
 	}
 	defer f.Close()
 
-	reader := bufio.NewReader(f)
+	return ReadSmoothLM(f)
+}
+
+// LoadSmoothLMFS loads a SmoothLM stored under name in fsys
+func LoadSmoothLMFS(fsys fs.FS, name string) (*SmoothLM, error) {
+	f, err := fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return ReadSmoothLM(f)
+}
+
+// ReadSmoothLM reads a SmoothLM in Java zemberek's binary format from r
+func ReadSmoothLM(r io.Reader) (*SmoothLM, error) {
+	reader := bufio.NewReader(r)
 
 	var version int32
 	if err := binary.Read(reader, binary.BigEndian, &version); err != nil {
@@ -1,6 +1,7 @@
 package lm
 
 import (
+    "io/fs"
     "math"
     "os"
     "path/filepath"
@@ -59,3 +60,16 @@ func TryLoadUnigram(path string) (*UnigramLM, error) {
     return NewUnigramLM(lu), nil
 }
 
+// LoadUnigramFS loads a LossyIntLookup backed unigram model stored under name in fsys
+func LoadUnigramFS(fsys fs.FS, name string) (*UnigramLM, error) {
+    f, err := fsys.Open(name)
+    if err != nil {
+        return nil, err
+    }
+    defer f.Close()
+    lu, err := compression.DeserializeLossyIntLookup(f)
+    if err != nil {
+        return nil, err
+    }
+    return NewUnigramLM(lu), nil
+}
@@ -0,0 +1,18 @@
+package morphology
+
+import (
+	"strings"
+
+	"github.com/kalaomer/zemberek-go/morphology/lexicon"
+	"github.com/kalaomer/zemberek-go/tokenization"
+)
+
+// AddLexiconAbbreviations adds the pronunciations and expansions of the
+// abbreviation dictionary of the lexicon to registry, a registry created
+// with tokenization.NewDefaultAbbreviationRegistry. Suffixes can then be
+// attached with
+//
+//	suffix.Attacher{Pronunciations: registry.Pronunciations()}
+func AddLexiconAbbreviations(registry *tokenization.AbbreviationRegistry) error {
+	return registry.ReadDictionary(strings.NewReader(lexicon.AbbreviationsDictionary()))
+}
@@ -0,0 +1,20 @@
+package morphology
+
+import (
+	"testing"
+
+	"github.com/kalaomer/zemberek-go/tokenization"
+)
+
+func TestAddLexiconAbbreviations(t *testing.T) {
+	registry := tokenization.NewDefaultAbbreviationRegistry()
+	if err := AddLexiconAbbreviations(registry); err != nil {
+		t.Fatal(err)
+	}
+	if got := registry.Pronunciation("Prof."); got != "profesör" {
+		t.Errorf("Pronunciation(Prof.) = %q", got)
+	}
+	if got := registry.Pronunciation("A1"); got != "abir" {
+		t.Errorf("Pronunciation(A1) = %q", got)
+	}
+}
@@ -0,0 +1,158 @@
+package analysis
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kalaomer/zemberek-go/core/turkish"
+	"github.com/kalaomer/zemberek-go/morphology/lexicon"
+	"github.com/kalaomer/zemberek-go/morphology/morphotactics"
+)
+
+// Parse parses an analysis string back into a SingleAnalysis. Two formats
+// are accepted:
+//
+//	[kitap:Noun] kitap:Noun+lar:A3pl+ım:P1sg        (FormatString)
+//	[kitap:Noun] Noun+A3pl+P1sg                     (Java lexical format)
+//
+// Derivations are written as "|lük:Ness→Noun" or "|Ness→Noun". The
+// dictionary item is resolved from lex by lemma and POS, and morphemes by
+// their IDs in the morpheme map. As in FromSearchPath, Pnon and Nom morphemes
+// are dropped. The lexical format carries no surfaces, so the stem surface is
+// set to the root of the item and suffix surfaces are left empty.
+func Parse(s string, lex *lexicon.RootLexicon) (*SingleAnalysis, error) {
+	s = strings.TrimSpace(s)
+	if !strings.HasPrefix(s, "[") {
+		return nil, fmt.Errorf("analysis: %q does not start with a dictionary item", s)
+	}
+	end := strings.Index(s, "] ")
+	if end < 0 {
+		return nil, fmt.Errorf("analysis: %q has no morphemes", s)
+	}
+
+	item, err := parseItem(s[1:end], lex)
+	if err != nil {
+		return nil, fmt.Errorf("analysis: %q: %w", s, err)
+	}
+	morphemes, err := parseMorphemes(strings.TrimSpace(s[end+2:]), item)
+	if err != nil {
+		return nil, fmt.Errorf("analysis: %q: %w", s, err)
+	}
+
+	derivationCount := 0
+	for _, md := range morphemes {
+		if md.Morpheme.Derivational {
+			derivationCount++
+		}
+	}
+	groupBoundaries := make([]int, 1, derivationCount+1)
+	for i, md := range morphemes {
+		if md.Morpheme.Derivational {
+			groupBoundaries = append(groupBoundaries, i)
+		}
+	}
+
+	return NewSingleAnalysis(item, morphemes, groupBoundaries), nil
+}
+
+// parseItem resolves the dictionary item of "lemma:Pos" or
+// "lemma:Pos, SecondaryPos"
+func parseItem(header string, lex *lexicon.RootLexicon) (*lexicon.DictionaryItem, error) {
+	secondary := turkish.NonePos
+	secondaryGiven := false
+	if i := strings.LastIndex(header, ","); i > 0 && strings.LastIndex(header, ":") < i {
+		spos, ok := turkish.SecondaryPosFromString(strings.TrimSpace(header[i+1:]))
+		if !ok {
+			return nil, fmt.Errorf("unknown secondary POS %q", strings.TrimSpace(header[i+1:]))
+		}
+		secondary = spos
+		secondaryGiven = true
+		header = header[:i]
+	}
+
+	colon := strings.LastIndex(header, ":")
+	if colon <= 0 {
+		return nil, fmt.Errorf("dictionary item %q is not in lemma:POS form", header)
+	}
+	lemma := header[:colon]
+	pos, ok := turkish.PrimaryPosFromString(strings.TrimSpace(header[colon+1:]))
+	if !ok {
+		return nil, fmt.Errorf("unknown POS %q", header[colon+1:])
+	}
+
+	if lemma == lexicon.Unknown.Lemma && pos == lexicon.Unknown.PrimaryPos {
+		return lexicon.Unknown, nil
+	}
+	if lex == nil {
+		return nil, fmt.Errorf("no lexicon to resolve %s", header)
+	}
+
+	// Items without a secondary POS are printed without one, but Java output
+	// may omit it for other items too
+	var fallback *lexicon.DictionaryItem
+	for _, item := range lex.GetItems(lemma) {
+		if item.PrimaryPos != pos {
+			continue
+		}
+		if item.SecondaryPos == secondary {
+			return item, nil
+		}
+		if !secondaryGiven && fallback == nil {
+			fallback = item
+		}
+	}
+	if fallback != nil {
+		return fallback, nil
+	}
+	return nil, fmt.Errorf("no dictionary item for %s", header)
+}
+
+// parseMorphemes parses the morpheme part of an analysis string
+func parseMorphemes(s string, item *lexicon.DictionaryItem) ([]*MorphemeData, error) {
+	if s == "" {
+		return nil, fmt.Errorf("no morphemes")
+	}
+	morphemeMap := morphotactics.GetMorphemeMap()
+	if item == lexicon.Unknown {
+		morphemeMap = map[string]*morphotactics.Morpheme{morphotactics.UnknownMorpheme.ID: morphotactics.UnknownMorpheme}
+	}
+
+	result := make([]*MorphemeData, 0)
+	for i, group := range strings.Split(s, "|") {
+		for j, part := range strings.Split(group, "→") {
+			if i > 0 && j > 1 {
+				return nil, fmt.Errorf("derivation %q has more than one →", group)
+			}
+			for _, token := range strings.Split(part, "+") {
+				if token == "" {
+					// Derivations end with "→" when nothing follows them
+					continue
+				}
+				surface, id := "", token
+				if colon := strings.LastIndex(token, ":"); colon >= 0 {
+					surface, id = token[:colon], token[colon+1:]
+				}
+				morpheme, ok := morphemeMap[id]
+				if !ok {
+					return nil, fmt.Errorf("unknown morpheme %q", id)
+				}
+				if i > 0 && j == 0 && !morpheme.Derivational {
+					return nil, fmt.Errorf("morpheme %s after | is not derivational", id)
+				}
+				if morpheme.ID == "Pnon" || morpheme.ID == "Nom" {
+					continue
+				}
+				result = append(result, NewMorphemeData(morpheme, surface))
+			}
+		}
+	}
+
+	if len(result) == 0 {
+		return nil, fmt.Errorf("no morphemes")
+	}
+	// Lexical format has no stem surface
+	if result[0].Surface == "" {
+		result[0].Surface = item.Root
+	}
+	return result, nil
+}
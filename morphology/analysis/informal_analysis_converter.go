@@ -1,26 +1,48 @@
 package analysis
 
 import (
-	"github.com/kalaomer/zemberek-go/morphology/generator"
+	"github.com/kalaomer/zemberek-go/morphology/lexicon"
 	"github.com/kalaomer/zemberek-go/morphology/morphotactics"
 )
 
+// GenerationResult is a generated word and its analysis. generator.Result is
+// an alias of it.
+type GenerationResult struct {
+	Surface  string
+	Analysis *SingleAnalysis
+}
+
+// String returns string representation
+func (r *GenerationResult) String() string {
+	if r.Analysis != nil {
+		return r.Surface + "-" + r.Analysis.String()
+	}
+	return r.Surface
+}
+
+// WordGenerator generates the words of a dictionary item with morphemes.
+// generator.WordGenerator implements it; the generator package imports this
+// one, so the converter depends on the interface.
+type WordGenerator interface {
+	Generate(item *lexicon.DictionaryItem, morphemes []*morphotactics.Morpheme) []*GenerationResult
+}
+
 // InformalAnalysisConverter converts informal morphemes to formal ones
 type InformalAnalysisConverter struct {
-	Generator *generator.WordGenerator
+	Generator WordGenerator
 }
 
 // NewInformalAnalysisConverter creates a new converter
-func NewInformalAnalysisConverter(gen *generator.WordGenerator) *InformalAnalysisConverter {
+func NewInformalAnalysisConverter(gen WordGenerator) *InformalAnalysisConverter {
 	return &InformalAnalysisConverter{
 		Generator: gen,
 	}
 }
 
 // Convert converts informal analysis to formal
-func (iac *InformalAnalysisConverter) Convert(input string, analysis *SingleAnalysis) *generator.Result {
+func (iac *InformalAnalysisConverter) Convert(input string, analysis *SingleAnalysis) *GenerationResult {
 	if !analysis.ContainsInformalMorpheme() {
-		return &generator.Result{
+		return &GenerationResult{
 			Surface:  input,
 			Analysis: analysis,
 		}
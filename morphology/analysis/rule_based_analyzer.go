@@ -37,6 +37,18 @@ func NewIgnoreDiacriticsAnalyzer(morph *morphotactics.TurkishMorphotactics) *Rul
 
 // Analyze analyzes a word
 func (rba *RuleBasedAnalyzer) Analyze(input string) []*SingleAnalysis {
+	return rba.analyze(input, nil)
+}
+
+// AnalyzeWithTrace analyzes a word and also returns a trace of the search,
+// containing every explored search path and every attempted transition with
+// the reason it was rejected
+func (rba *RuleBasedAnalyzer) AnalyzeWithTrace(input string) ([]*SingleAnalysis, *AnalysisTrace) {
+	trace := NewAnalysisTrace(input)
+	return rba.analyze(input, trace), trace
+}
+
+func (rba *RuleBasedAnalyzer) analyze(input string, trace *AnalysisTrace) []*SingleAnalysis {
 	// Get stem candidates
 	candidates := rba.StemTransitions.GetPrefixMatches(input, rba.ASCIITolerant)
 
@@ -52,7 +64,7 @@ func (rba *RuleBasedAnalyzer) Analyze(input string) []*SingleAnalysis {
 	}
 
 	// Search graph
-	resultPaths := rba.Search(paths)
+	resultPaths := rba.search(paths, trace)
 
 	// Generate results from successful paths
 	result := make([]*SingleAnalysis, 0, len(resultPaths))
@@ -66,8 +78,31 @@ func (rba *RuleBasedAnalyzer) Analyze(input string) []*SingleAnalysis {
 
 // Search performs graph search for analysis
 func (rba *RuleBasedAnalyzer) Search(currentPaths []*SearchPath) []*SearchPath {
+	return rba.search(currentPaths, nil)
+}
+
+// SearchWithTrace performs graph search like Search and also returns a trace
+// of it, as AnalyzeWithTrace does. The trace input is empty.
+func (rba *RuleBasedAnalyzer) SearchWithTrace(currentPaths []*SearchPath) ([]*SearchPath, *AnalysisTrace) {
+	trace := NewAnalysisTrace("")
+	return rba.search(currentPaths, trace), trace
+}
+
+func (rba *RuleBasedAnalyzer) search(currentPaths []*SearchPath, trace *AnalysisTrace) []*SearchPath {
 	if len(currentPaths) > 30 {
-		currentPaths = rba.PruneCyclicPaths(currentPaths)
+		pruned := rba.PruneCyclicPaths(currentPaths)
+		if trace != nil {
+			kept := make(map[*SearchPath]bool, len(pruned))
+			for _, path := range pruned {
+				kept[path] = true
+			}
+			for _, path := range currentPaths {
+				if !kept[path] {
+					trace.Pruned = append(trace.Pruned, path)
+				}
+			}
+		}
+		currentPaths = pruned
 	}
 
 	result := make([]*SearchPath, 0)
@@ -76,6 +111,10 @@ func (rba *RuleBasedAnalyzer) Search(currentPaths []*SearchPath) []*SearchPath {
 		allNewPaths := make([]*SearchPath, 0)
 
 		for _, path := range currentPaths {
+			if trace != nil {
+				trace.Paths = append(trace.Paths, path)
+			}
+
 			// If tail is empty and path is terminal, add to results
 			if len(path.Tail) == 0 {
 				if path.Terminal && !path.PhoneticAttributes[turkish.CannotTerminate] {
@@ -84,24 +123,29 @@ func (rba *RuleBasedAnalyzer) Search(currentPaths []*SearchPath) []*SearchPath {
 				}
 			}
 
-			newPaths := rba.Advance(path)
+			newPaths := rba.advance(path, trace)
 			allNewPaths = append(allNewPaths, newPaths...)
 		}
 
 		currentPaths = allNewPaths
 	}
 
+	if trace != nil {
+		trace.Results = result
+	}
 	return result
 }
 
 // Advance advances a search path
 func (rba *RuleBasedAnalyzer) Advance(path *SearchPath) []*SearchPath {
-	newPaths := make([]*SearchPath, 0, 2)
+	return rba.advance(path, nil)
+}
 
-	if rba.DebugMode {
-		fmt.Printf("\n[ADVANCE] State=%s, Tail='%s', Outgoing=%d\n",
-			path.CurrentState.ID, path.Tail, len(path.CurrentState.Outgoing))
-	}
+// advance advances a search path. Attempted transitions are recorded to trace
+// if it is not nil and printed in debug mode.
+func (rba *RuleBasedAnalyzer) advance(path *SearchPath, trace *AnalysisTrace) []*SearchPath {
+	newPaths := make([]*SearchPath, 0, 2)
+	tracing := trace != nil || rba.DebugMode
 
 	// For all outgoing transitions
 	for _, transition := range path.CurrentState.Outgoing {
@@ -110,15 +154,10 @@ func (rba *RuleBasedAnalyzer) Advance(path *SearchPath) []*SearchPath {
 			continue
 		}
 
-		if rba.DebugMode {
-			fmt.Printf("  [TRANSITION] %s -> %s\n",
-				path.CurrentState.ID, suffixTransition.To.ID)
-		}
-
 		// If tail is empty and this transition has surface, skip
 		if len(path.Tail) == 0 && suffixTransition.HasSurfaceForm() {
-			if rba.DebugMode {
-				fmt.Printf("    [SKIP] Tail empty but has surface form\n")
+			if tracing {
+				rba.record(trace, &TraceStep{Path: path, Transition: suffixTransition, Outcome: TraceEmptyTail})
 			}
 			continue
 		}
@@ -126,10 +165,6 @@ func (rba *RuleBasedAnalyzer) Advance(path *SearchPath) []*SearchPath {
 		// Generate surface form
 		surface := GenerateSurface(suffixTransition, path.PhoneticAttributes)
 
-		if rba.DebugMode {
-			fmt.Printf("    [SURFACE] '%s'\n", surface)
-		}
-
 		// Check if tail starts with surface
 		tailStartsWith := false
 		if rba.ASCIITolerant {
@@ -139,79 +174,47 @@ func (rba *RuleBasedAnalyzer) Advance(path *SearchPath) []*SearchPath {
 		}
 
 		if !tailStartsWith {
-			if rba.DebugMode {
-				fmt.Printf("    [SKIP] Tail doesn't start with surface\n")
+			if tracing {
+				rba.record(trace, &TraceStep{Path: path, Transition: suffixTransition, Surface: surface,
+					Outcome: TraceSurfaceMismatch})
 			}
 			continue
 		}
 
-		if rba.DebugMode {
-			fmt.Printf("    [MATCH] Tail starts with surface\n")
-		}
-
 		// Check conditions
-		canPass := suffixTransition.CanPass(path)
-		if rba.DebugMode {
-			if suffixTransition.Condition != nil {
-				fmt.Printf("    [CONDITION] Type=%T Ptr=%p\n", suffixTransition.Condition, suffixTransition.Condition)
-				// Check if it's ExpectsConsonant/ExpectsVowel check
-				ec := turkish.ExpectsConsonant
-				ev := turkish.ExpectsVowel
-				hasEC := path.PhoneticAttributes[ec]
-				hasEV := path.PhoneticAttributes[ev]
-				fmt.Printf("    [CONDITION_DEBUG] ExpectsConsonant=%v ExpectsVowel=%v\n", hasEC, hasEV)
-
-				// Manual condition test
-				manualResult := suffixTransition.Condition.Accept(path)
-				fmt.Printf("    [CONDITION_MANUAL] Accept()=%v\n", manualResult)
-			}
-			fmt.Printf("    [ATTRIBUTES] ")
-			for attr := range path.PhoneticAttributes {
-				fmt.Printf("%s(%d) ", attr.GetStringForm(), attr)
-			}
-			fmt.Printf("→CanPass=%v\n", canPass)
-		}
-		if !canPass {
-			if rba.DebugMode {
-				fmt.Printf("    [SKIP] Condition check failed\n")
+		if !suffixTransition.CanPass(path) {
+			if tracing {
+				rba.record(trace, &TraceStep{Path: path, Transition: suffixTransition, Surface: surface,
+					Outcome: TraceConditionRejected, Condition: morphotactics.RejectingCondition(suffixTransition.Condition, path)})
 			}
 			continue
 		}
 
-		if rba.DebugMode {
-			fmt.Printf("    [PASS] Condition check passed\n")
-		}
-
 		// Epsilon (empty) transition - use existing attributes
 		if !suffixTransition.HasSurfaceForm() {
-			if rba.DebugMode {
-				fmt.Printf("    [EPSILON] Preserving attributes: ")
-				for attr := range path.PhoneticAttributes {
-					fmt.Printf("%v ", attr)
-				}
-				fmt.Println()
+			p := path.GetCopy(NewSurfaceTransition("", suffixTransition), path.PhoneticAttributes)
+			if tracing {
+				rba.record(trace, &TraceStep{Path: path, Transition: suffixTransition, Outcome: TraceAccepted, Next: p})
 			}
-			newPaths = append(newPaths, path.GetCopy(
-				NewSurfaceTransition("", suffixTransition),
-				path.PhoneticAttributes))
+			newPaths = append(newPaths, p)
 			continue
 		}
 
 		surfaceTransition := NewSurfaceTransition(surface, suffixTransition)
 
 		// If tail equals surface, no need to recalculate attributes
-    var attributes map[turkish.PhoneticAttribute]bool
-    tailEqualsSurface := false
-    if rba.ASCIITolerant {
-        // Guard against empty strings to avoid index errors in EqualsIgnoreDiacritics
-        if len(path.Tail) == len(surface) && len(surface) > 0 {
-            tailEqualsSurface = turkish.Instance.EqualsIgnoreDiacritics(path.Tail, surface)
-        } else {
-            tailEqualsSurface = path.Tail == surface
-        }
-    } else {
-        tailEqualsSurface = path.Tail == surface
-    }
+		var attributes map[turkish.PhoneticAttribute]bool
+		tailEqualsSurface := false
+		if rba.ASCIITolerant {
+			// Guard against empty strings to avoid index errors in EqualsIgnoreDiacritics
+			if len(path.Tail) == len(surface) && len(surface) > 0 {
+				tailEqualsSurface = turkish.Instance.EqualsIgnoreDiacritics(path.Tail, surface)
+			} else {
+				tailEqualsSurface = path.Tail == surface
+			}
+		} else {
+			tailEqualsSurface = path.Tail == surface
+		}
 
 		if tailEqualsSurface {
 			// Copy attributes
@@ -238,12 +241,26 @@ func (rba *RuleBasedAnalyzer) Advance(path *SearchPath) []*SearchPath {
 		}
 
 		p := path.GetCopy(surfaceTransition, attributes)
+		if tracing {
+			rba.record(trace, &TraceStep{Path: path, Transition: suffixTransition, Surface: surface,
+				Outcome: TraceAccepted, Next: p})
+		}
 		newPaths = append(newPaths, p)
 	}
 
 	return newPaths
 }
 
+// record adds a step to trace and prints it in debug mode
+func (rba *RuleBasedAnalyzer) record(trace *AnalysisTrace, step *TraceStep) {
+	if trace != nil {
+		trace.Steps = append(trace.Steps, step)
+	}
+	if rba.DebugMode {
+		fmt.Println(step)
+	}
+}
+
 // PruneCyclicPaths removes paths with too many repetitions
 func (rba *RuleBasedAnalyzer) PruneCyclicPaths(paths []*SearchPath) []*SearchPath {
 	result := make([]*SearchPath, 0)
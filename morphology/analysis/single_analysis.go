@@ -219,3 +219,189 @@ func (sa *SingleAnalysis) IsRuntime() bool {
 	// Would check: sa.Item.HasAttribute(turkish.Runtime)
 	return false
 }
+
+// MorphemeGroup is an inflectional group of an analysis. The first group
+// starts with the stem, the others with a derivational morpheme. Each group
+// continues with the inflections until the next derivation.
+type MorphemeGroup struct {
+	Morphemes []*MorphemeData
+}
+
+// NewMorphemeGroup creates a new MorphemeGroup
+func NewMorphemeGroup(morphemes []*MorphemeData) *MorphemeGroup {
+	return &MorphemeGroup{Morphemes: morphemes}
+}
+
+// GetPos returns the POS of the group, or UnknownPos if it contains no POS
+// morpheme
+func (mg *MorphemeGroup) GetPos() turkish.PrimaryPos {
+	for _, md := range mg.Morphemes {
+		if md.Morpheme.HasPos {
+			return md.Morpheme.Pos
+		}
+	}
+	return turkish.UnknownPos
+}
+
+// SurfaceForm returns the concatenated surfaces of the group
+func (mg *MorphemeGroup) SurfaceForm() string {
+	var sb strings.Builder
+	for _, md := range mg.Morphemes {
+		sb.WriteString(md.Surface)
+	}
+	return sb.String()
+}
+
+// GetMorphemes returns the morphemes of the group
+func (mg *MorphemeGroup) GetMorphemes() []*morphotactics.Morpheme {
+	morphemes := make([]*morphotactics.Morpheme, len(mg.Morphemes))
+	for i, md := range mg.Morphemes {
+		morphemes[i] = md.Morpheme
+	}
+	return morphemes
+}
+
+// LexicalForm returns morpheme IDs of the group joined with "+"
+func (mg *MorphemeGroup) LexicalForm() string {
+	ids := make([]string, len(mg.Morphemes))
+	for i, md := range mg.Morphemes {
+		ids[i] = md.Morpheme.ID
+	}
+	return strings.Join(ids, "+")
+}
+
+// String returns string representation
+func (mg *MorphemeGroup) String() string {
+	parts := make([]string, len(mg.Morphemes))
+	for i, md := range mg.Morphemes {
+		parts[i] = md.String()
+	}
+	return strings.Join(parts, "+")
+}
+
+// GroupCount returns the number of inflectional groups
+func (sa *SingleAnalysis) GroupCount() int {
+	return len(sa.GroupBoundaries)
+}
+
+// GetGroup returns the inflectional group at index, or nil if it is out of
+// range
+func (sa *SingleAnalysis) GetGroup(index int) *MorphemeGroup {
+	if index < 0 || index >= len(sa.GroupBoundaries) {
+		return nil
+	}
+	start := sa.GroupBoundaries[index]
+	end := len(sa.MorphemeDataList)
+	if index < len(sa.GroupBoundaries)-1 {
+		end = sa.GroupBoundaries[index+1]
+	}
+	return NewMorphemeGroup(sa.MorphemeDataList[start:end])
+}
+
+// GetGroups returns all inflectional groups
+func (sa *SingleAnalysis) GetGroups() []*MorphemeGroup {
+	groups := make([]*MorphemeGroup, len(sa.GroupBoundaries))
+	for i := range sa.GroupBoundaries {
+		groups[i] = sa.GetGroup(i)
+	}
+	return groups
+}
+
+// GetLastGroup returns the last inflectional group
+func (sa *SingleAnalysis) GetLastGroup() *MorphemeGroup {
+	return sa.GetGroup(len(sa.GroupBoundaries) - 1)
+}
+
+// GetPos returns the POS of the word form, which is the POS of the last
+// inflectional group. Falls back to the POS of the dictionary item.
+func (sa *SingleAnalysis) GetPos() turkish.PrimaryPos {
+	if group := sa.GetLastGroup(); group != nil {
+		if pos := group.GetPos(); pos != turkish.UnknownPos {
+			return pos
+		}
+	}
+	return sa.Item.PrimaryPos
+}
+
+// GetStems returns the surface stems of the analysis: the stem, then the
+// stem of each derived form. For "kitapçığa" the stems are "kitap" and
+// "kitapçığ".
+func (sa *SingleAnalysis) GetStems() []string {
+	stems := []string{sa.GetStem()}
+	if len(sa.GroupBoundaries) < 2 {
+		return stems
+	}
+
+	previousStem := sa.GetGroup(0).SurfaceForm()
+	for i := 1; i < len(sa.GroupBoundaries); i++ {
+		group := sa.GetGroup(i)
+		stem := previousStem + group.Morphemes[0].Surface
+		if !containsString(stems, stem) {
+			stems = append(stems, stem)
+		}
+		previousStem += group.SurfaceForm()
+	}
+	return stems
+}
+
+// GetLemmas returns the root and the lemmas of the derived forms. Unlike
+// stems, a final "ğ" of a derived lemma is restored to "k", so the lemmas of
+// "kitapçığa" are "kitap" and "kitapçık".
+func (sa *SingleAnalysis) GetLemmas() []string {
+	lemmas := []string{sa.Item.Root}
+	if len(sa.GroupBoundaries) < 2 {
+		return lemmas
+	}
+
+	previousStem := sa.GetGroup(0).SurfaceForm()
+	if previousStem != sa.Item.Root {
+		previousStem = restoreVoicing(previousStem)
+	}
+	for i := 1; i < len(sa.GroupBoundaries); i++ {
+		group := sa.GetGroup(i)
+		lemma := restoreVoicing(previousStem + group.Morphemes[0].Surface)
+		if !containsString(lemmas, lemma) {
+			lemmas = append(lemmas, lemma)
+		}
+		previousStem += group.SurfaceForm()
+	}
+	return lemmas
+}
+
+func restoreVoicing(s string) string {
+	if strings.HasSuffix(s, "ğ") {
+		return strings.TrimSuffix(s, "ğ") + "k"
+	}
+	return s
+}
+
+func containsString(list []string, s string) bool {
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
+
+// ContainsAnyMorpheme checks if the analysis contains any of the morphemes
+func (sa *SingleAnalysis) ContainsAnyMorpheme(morphemes ...*morphotactics.Morpheme) bool {
+	for _, morpheme := range morphemes {
+		if sa.ContainsMorpheme(morpheme) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetDerivationalChain returns the derivational morphemes of the analysis in
+// order, one for each group after the first
+func (sa *SingleAnalysis) GetDerivationalChain() []*morphotactics.Morpheme {
+	chain := make([]*morphotactics.Morpheme, 0)
+	for _, md := range sa.MorphemeDataList {
+		if md.Morpheme.Derivational {
+			chain = append(chain, md.Morpheme)
+		}
+	}
+	return chain
+}
@@ -36,6 +36,12 @@ func (st *SurfaceTransition) GetMorpheme() *morphotactics.Morpheme {
 	return state.Morpheme
 }
 
+// GetLexicalTransition returns the morphotactics transition this surface
+// transition was created from
+func (st *SurfaceTransition) GetLexicalTransition() morphotactics.MorphemeTransition {
+	return st.LexicalTransition
+}
+
 // GetSurface returns the surface string (for TransitionInterface)
 func (st *SurfaceTransition) GetSurface() string {
 	return st.Surface
@@ -190,9 +196,17 @@ func GenerateSurface(transition *morphotactics.SuffixTransition, phoneticAttrs m
 		return cached
 	}
 
+	surface := GenerateSurfaceFromTokens(transition.TokenList, phoneticAttrs)
+	transition.AddToSurfaceCache(phoneticAttrs, surface)
+	return surface
+}
+
+// GenerateSurfaceFromTokens generates the surface of a tokenized suffix
+// template following a sequence with phoneticAttrs
+func GenerateSurfaceFromTokens(tokens []*morphotactics.SuffixTemplateToken, phoneticAttrs map[turkish.PhoneticAttribute]bool) string {
 	var result []rune
 
-	for index, token := range transition.TokenList {
+	for index, token := range tokens {
 		// Get current morphemic attributes
 		attrs := GetMorphemicAttributes(string(result), phoneticAttrs)
 
@@ -243,7 +257,5 @@ func GenerateSurface(transition *morphotactics.SuffixTransition, phoneticAttrs m
 		}
 	}
 
-	surface := string(result)
-	transition.AddToSurfaceCache(phoneticAttrs, surface)
-	return surface
+	return string(result)
 }
@@ -0,0 +1,141 @@
+package analysis
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/kalaomer/zemberek-go/morphology/morphotactics"
+)
+
+// TraceOutcome is the result of attempting a suffix transition on a search path
+type TraceOutcome int
+
+const (
+	// TraceAccepted means the transition created a new search path
+	TraceAccepted TraceOutcome = iota
+	// TraceEmptyTail means the input is consumed but the transition has a surface form
+	TraceEmptyTail
+	// TraceSurfaceMismatch means the generated surface is not a prefix of the tail
+	TraceSurfaceMismatch
+	// TraceConditionRejected means the transition condition rejected the path
+	TraceConditionRejected
+)
+
+// String returns string representation
+func (o TraceOutcome) String() string {
+	switch o {
+	case TraceAccepted:
+		return "accepted"
+	case TraceEmptyTail:
+		return "tail empty but transition has surface"
+	case TraceSurfaceMismatch:
+		return "surface does not match tail"
+	case TraceConditionRejected:
+		return "rejected by condition"
+	}
+	return fmt.Sprintf("TraceOutcome(%d)", int(o))
+}
+
+// TraceStep records a single suffix transition attempted during analysis
+type TraceStep struct {
+	// Path is the search path the transition is attempted from
+	Path       *SearchPath
+	Transition *morphotactics.SuffixTransition
+	// Surface is the surface generated for the transition. It is empty for
+	// TraceEmptyTail as no surface is generated.
+	Surface string
+	Outcome TraceOutcome
+	// Condition is the part of the transition condition that rejected the
+	// path, set only for TraceConditionRejected
+	Condition morphotactics.Condition
+	// Next is the new search path, set only for TraceAccepted
+	Next *SearchPath
+}
+
+// String returns string representation
+func (s *TraceStep) String() string {
+	result := fmt.Sprintf("%s %s %q: %s", s.Path, s.Transition, s.Surface, s.Outcome)
+	if s.Condition != nil {
+		result += " " + morphotactics.ConditionString(s.Condition)
+	}
+	return result
+}
+
+// AnalysisTrace explains how an analysis was reached. It contains every
+// search path explored and every transition attempted on them, including the
+// rejected ones.
+type AnalysisTrace struct {
+	Input string
+	// Paths contains all explored search paths in the order they are visited,
+	// starting with the initial paths created from stem candidates
+	Paths []*SearchPath
+	// Pruned contains initial paths removed by PruneCyclicPaths
+	Pruned  []*SearchPath
+	Steps   []*TraceStep
+	Results []*SearchPath
+}
+
+// NewAnalysisTrace creates an empty trace for input
+func NewAnalysisTrace(input string) *AnalysisTrace {
+	return &AnalysisTrace{Input: input}
+}
+
+// Rejected returns the steps that did not create a new search path
+func (t *AnalysisTrace) Rejected() []*TraceStep {
+	result := make([]*TraceStep, 0)
+	for _, step := range t.Steps {
+		if step.Outcome != TraceAccepted {
+			result = append(result, step)
+		}
+	}
+	return result
+}
+
+// StepsFrom returns the steps attempted from path
+func (t *AnalysisTrace) StepsFrom(path *SearchPath) []*TraceStep {
+	result := make([]*TraceStep, 0)
+	for _, step := range t.Steps {
+		if step.Path == path {
+			result = append(result, step)
+		}
+	}
+	return result
+}
+
+// WriteTo writes a human readable dump of the trace. Each explored path is
+// followed by the transitions attempted from it.
+func (t *AnalysisTrace) WriteTo(w io.Writer) (int64, error) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Input: %s\n", t.Input)
+	for _, path := range t.Pruned {
+		fmt.Fprintf(&b, "Pruned %s\n", path)
+	}
+
+	steps := make(map[*SearchPath][]*TraceStep)
+	for _, step := range t.Steps {
+		steps[step.Path] = append(steps[step.Path], step)
+	}
+	results := make(map[*SearchPath]bool)
+	for _, path := range t.Results {
+		results[path] = true
+	}
+
+	for _, path := range t.Paths {
+		if results[path] {
+			fmt.Fprintf(&b, "Result %s\n", path)
+			continue
+		}
+		fmt.Fprintf(&b, "Path %s\n", path)
+		for _, step := range steps[path] {
+			fmt.Fprintf(&b, "  %s %q: %s", step.Transition, step.Surface, step.Outcome)
+			if step.Condition != nil {
+				b.WriteString(" " + morphotactics.ConditionString(step.Condition))
+			}
+			b.WriteString("\n")
+		}
+	}
+
+	n, err := io.WriteString(w, b.String())
+	return int64(n), err
+}
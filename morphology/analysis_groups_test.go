@@ -0,0 +1,73 @@
+package morphology
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kalaomer/zemberek-go/core/turkish"
+	"github.com/kalaomer/zemberek-go/morphology/morphotactics"
+)
+
+func TestSingleAnalysisGroups(t *testing.T) {
+	morph := testMorphology()
+	a := findAnalysis(t, morph, "gözlüklerdeki",
+		"[göz:Noun] göz:Noun+A3sg|lük:Ness→Noun+ler:A3pl+de:Loc|ki:Rel→Adj")
+
+	groups := a.GetGroups()
+	if len(groups) != 3 || a.GroupCount() != 3 {
+		t.Fatalf("expected 3 groups, got %v", groups)
+	}
+	expected := []struct {
+		lexical string
+		surface string
+		pos     turkish.PrimaryPos
+	}{
+		{"Noun+A3sg", "göz", turkish.Noun},
+		{"Ness+Noun+A3pl+Loc", "lüklerde", turkish.Noun},
+		{"Rel+Adj", "ki", turkish.Adjective},
+	}
+	for i, e := range expected {
+		if groups[i].LexicalForm() != e.lexical || groups[i].SurfaceForm() != e.surface || groups[i].GetPos() != e.pos {
+			t.Errorf("group %d: got %s %q %v", i, groups[i].LexicalForm(), groups[i].SurfaceForm(), groups[i].GetPos())
+		}
+	}
+	if a.GetLastGroup().LexicalForm() != "Rel+Adj" || a.GetGroup(3) != nil {
+		t.Error("unexpected last group")
+	}
+
+	if a.GetPos() != turkish.Adjective {
+		t.Errorf("expected final POS Adj, got %v", a.GetPos())
+	}
+	if got := a.GetStems(); !reflect.DeepEqual(got, []string{"göz", "gözlük", "gözlüklerdeki"}) {
+		t.Errorf("unexpected stems %v", got)
+	}
+	if got := a.GetLemmas(); !reflect.DeepEqual(got, []string{"göz", "gözlük", "gözlüklerdeki"}) {
+		t.Errorf("unexpected lemmas %v", got)
+	}
+	if got := a.GetDerivationalChain(); !reflect.DeepEqual(got, []*morphotactics.Morpheme{morphotactics.Ness, morphotactics.Rel}) {
+		t.Errorf("unexpected derivational chain %v", got)
+	}
+	if !a.ContainsAnyMorpheme(morphotactics.Dim, morphotactics.Rel) || a.ContainsAnyMorpheme(morphotactics.Dim, morphotactics.P1sg) {
+		t.Error("ContainsAnyMorpheme failed")
+	}
+}
+
+func TestSingleAnalysisLemmas(t *testing.T) {
+	morph := testMorphology()
+
+	a := findAnalysis(t, morph, "kitapçığa", "[kitap:Noun] kitap:Noun+A3sg|çığ:Dim→Noun+A3sg+a:Dat")
+	if got := a.GetStems(); !reflect.DeepEqual(got, []string{"kitap", "kitapçığ"}) {
+		t.Errorf("unexpected stems %v", got)
+	}
+	if got := a.GetLemmas(); !reflect.DeepEqual(got, []string{"kitap", "kitapçık"}) {
+		t.Errorf("unexpected lemmas %v", got)
+	}
+
+	a = findAnalysis(t, morph, "geliyorum", "[gelmek:Verb] gel:Verb+iyor:Prog1+um:A1sg")
+	if a.GroupCount() != 1 || a.GetPos() != turkish.Verb || len(a.GetDerivationalChain()) != 0 {
+		t.Errorf("unexpected groups %v", a.GetGroups())
+	}
+	if got := a.GetLemmas(); !reflect.DeepEqual(got, []string{"gel"}) {
+		t.Errorf("unexpected lemmas %v", got)
+	}
+}
@@ -0,0 +1,97 @@
+package morphology
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kalaomer/zemberek-go/core/turkish"
+	"github.com/kalaomer/zemberek-go/morphology/analysis"
+	"github.com/kalaomer/zemberek-go/morphology/lexicon"
+	"github.com/kalaomer/zemberek-go/morphology/morphotactics"
+)
+
+func TestParseAnalysisRoundTrip(t *testing.T) {
+	morph := testMorphology()
+	for _, word := range []string{"kitaplarım", "kitabımızdan", "gözlüklerdeki", "kitapçığa", "geliyorum", "gelmedik"} {
+		results := morph.Analyze(word).AnalysisResults
+		if len(results) == 0 {
+			t.Errorf("%s: no analysis", word)
+		}
+		for _, expected := range results {
+			parsed, err := morph.ParseAnalysis(expected.FormatString())
+			if err != nil {
+				t.Errorf("%s: %v", word, err)
+				continue
+			}
+			if parsed.Item != expected.Item || parsed.FormatString() != expected.FormatString() ||
+				!reflect.DeepEqual(parsed.GroupBoundaries, expected.GroupBoundaries) ||
+				parsed.SurfaceForm() != word {
+				t.Errorf("%s: parsed %s, expected %s", word, parsed.FormatString(), expected.FormatString())
+			}
+		}
+	}
+}
+
+func TestParseLexicalFormat(t *testing.T) {
+	morph := testMorphology()
+
+	a, err := morph.ParseAnalysis("[kitap:Noun] Noun+A3pl+P1sg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Item.ID != "kitap_Noun" || a.GetStem() != "kitap" ||
+		!reflect.DeepEqual(a.GetMorphemes(), []*morphotactics.Morpheme{morphotactics.Noun, morphotactics.A3pl, morphotactics.P1sg}) {
+		t.Errorf("unexpected analysis %s", a.FormatString())
+	}
+
+	// Java prints Pnon and Nom, they are dropped as in analyzer output
+	a, err = morph.ParseAnalysis("[göz:Noun] Noun+A3sg+Pnon+Nom|Ness→Noun+A3pl+Pnon+Loc|Rel→Adj")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.GroupCount() != 3 || a.GetPos() != turkish.Adjective || a.ContainsMorpheme(morphotactics.Pnon) {
+		t.Errorf("unexpected analysis %s", a.FormatString())
+	}
+	if a.GetLastGroup().LexicalForm() != "Rel+Adj" {
+		t.Errorf("unexpected last group %s", a.GetLastGroup())
+	}
+}
+
+func TestParseAnalysisItems(t *testing.T) {
+	lex := lexicon.NewRootLexicon([]*lexicon.DictionaryItem{
+		lexicon.NewDictionaryItem("ankara", "ankara", turkish.Noun, turkish.NonePos, nil, "", 0),
+		lexicon.NewDictionaryItem("Ankara", "ankara", turkish.Noun, turkish.ProperNoun, nil, "", 0),
+	})
+
+	a, err := analysis.Parse("[Ankara:Noun, Prop] ankara:Noun+A3sg+da:Loc", lex)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Item.SecondaryPos != turkish.ProperNoun || a.SurfaceForm() != "ankarada" {
+		t.Errorf("unexpected analysis %s", a.FormatString())
+	}
+
+	unknown := analysis.Unknown("xyz")
+	a, err = analysis.Parse(unknown.FormatString(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !a.IsUnknown() || a.GetStem() != "xyz" {
+		t.Errorf("unexpected analysis %s", a.FormatString())
+	}
+
+	for _, s := range []string{
+		"kitap:Noun+A3sg",
+		"[kitap:Noun]",
+		"[kitap] kitap:Noun",
+		"[kitap:Nown] kitap:Noun",
+		"[Ankara:Noun, Xyz] ankara:Noun",
+		"[kitap:Noun] kitap:Noun+A3sg",
+		"[ankara:Noun] ankara:Noun+A4sg",
+		"[ankara:Noun] ankara:Noun+A3sg|A3pl",
+	} {
+		if _, err := analysis.Parse(s, lex); err == nil {
+			t.Errorf("expected error for %q", s)
+		}
+	}
+}
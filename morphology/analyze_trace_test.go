@@ -0,0 +1,109 @@
+package morphology
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/kalaomer/zemberek-go/morphology/analysis"
+	"github.com/kalaomer/zemberek-go/morphology/morphotactics"
+)
+
+func TestAnalyzeWithTrace(t *testing.T) {
+	morph := testMorphology()
+
+	results, trace := morph.Analyzer.AnalyzeWithTrace("evlercik")
+	if len(results) != 0 {
+		t.Errorf("unexpected analyses %v", results)
+	}
+	if trace.Input != "evlercik" || len(trace.Paths) == 0 || len(trace.Steps) == 0 {
+		t.Fatal("trace is empty")
+	}
+
+	// Diminutive is not allowed after a suffix with surface
+	var rejected *analysis.TraceStep
+	for _, step := range trace.Rejected() {
+		if step.Outcome == analysis.TraceConditionRejected && step.Transition.To.ID == "dim_S" {
+			rejected = step
+		}
+	}
+	if rejected == nil {
+		t.Fatal("dim_S rejection not traced")
+	}
+	if rejected.Surface != "cik" || rejected.Path.Tail != "cik" {
+		t.Errorf("unexpected rejected step %s", rejected)
+	}
+	if got := morphotactics.ConditionString(rejected.Condition); got != "Not(HasAnySuffixSurface)" {
+		t.Errorf("unexpected rejecting condition %q", got)
+	}
+
+	outcomes := make(map[analysis.TraceOutcome]bool)
+	for _, step := range trace.Steps {
+		outcomes[step.Outcome] = true
+		if step.Outcome == analysis.TraceAccepted && step.Next == nil {
+			t.Errorf("accepted step without next path: %s", step)
+		}
+	}
+	for _, o := range []analysis.TraceOutcome{analysis.TraceAccepted, analysis.TraceSurfaceMismatch, analysis.TraceConditionRejected} {
+		if !outcomes[o] {
+			t.Errorf("no step with outcome %q", o)
+		}
+	}
+	if len(trace.StepsFrom(trace.Paths[0])) == 0 {
+		t.Error("no steps from the initial path")
+	}
+}
+
+func TestAnalyzeWithTraceResults(t *testing.T) {
+	morph := testMorphology()
+
+	word, trace := morph.AnalyzeWithTrace("Evler")
+	expected := morph.Analyze("Evler").AnalysisResults
+	if len(word.AnalysisResults) != len(expected) || len(expected) == 0 {
+		t.Fatalf("got %d analyses, expected %d", len(word.AnalysisResults), len(expected))
+	}
+	if len(trace.Results) != len(expected) {
+		t.Errorf("trace has %d results, expected %d", len(trace.Results), len(expected))
+	}
+
+	emptyTail := false
+	for _, step := range trace.Steps {
+		if step.Outcome == analysis.TraceEmptyTail {
+			emptyTail = true
+			if step.Path.Tail != "" {
+				t.Errorf("empty tail step with tail %q", step.Path.Tail)
+			}
+		}
+	}
+	if !emptyTail {
+		t.Error("no empty tail step")
+	}
+
+	var buf bytes.Buffer
+	if _, err := trace.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	dump := buf.String()
+	if !strings.HasPrefix(dump, "Input: evler\n") || !strings.Contains(dump, "Result ") ||
+		!strings.Contains(dump, "surface does not match tail") {
+		t.Errorf("unexpected trace dump:\n%s", dump)
+	}
+}
+
+func TestAnalyzeWithTraceApostropheAndNumber(t *testing.T) {
+	morph := testMorphology()
+
+	for word, input := range map[string]string{"Ankara'ya": "ankaraya", "3'e": "3'e"} {
+		analyzed, trace := morph.AnalyzeWithTrace(word)
+		expected := morph.Analyze(word).AnalysisResults
+		if len(expected) == 0 || len(analyzed.AnalysisResults) != len(expected) {
+			t.Errorf("%s: got %d analyses, expected %d", word, len(analyzed.AnalysisResults), len(expected))
+		}
+		if len(trace.Results) != len(expected) {
+			t.Errorf("%s: trace has %d results, expected %d", word, len(trace.Results), len(expected))
+		}
+		if trace.Input != input {
+			t.Errorf("%s: trace input %q, expected %q", word, trace.Input, input)
+		}
+	}
+}
@@ -0,0 +1,209 @@
+package corpus
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/kalaomer/zemberek-go/morphology/analysis"
+	"github.com/kalaomer/zemberek-go/morphology/lexicon"
+	"github.com/kalaomer/zemberek-go/morphology/ud"
+	"github.com/kalaomer/zemberek-go/tokenization"
+)
+
+// WriteCoNLLU writes doc in CoNLL-U format. LEMMA, UPOS, XPOS and FEATS come
+// from mapping, or from ud.DefaultMapping if it is nil. Syntactic columns are
+// left empty. MISC contains
+//
+//	TokenRange=<start>:<end>   half-open rune offsets in the sentence text
+//	SpaceAfter=No              if the next token follows without space
+//	Analysis=<analysis>        FormatString of the best analysis
+//
+// The analysis is percent encoded so that it holds no "|", "=" or white
+// space, which would clash with the MISC separators and the columns.
+func WriteCoNLLU(w io.Writer, doc *Document, mapping *ud.Mapping) error {
+	if mapping == nil {
+		mapping = ud.DefaultMapping()
+	}
+	bw := bufio.NewWriter(w)
+	for i, sentence := range doc.Sentences {
+		id := sentence.ID
+		if id == "" {
+			id = strconv.Itoa(i + 1)
+		}
+		fmt.Fprintf(bw, "# sent_id = %s\n", id)
+		fmt.Fprintf(bw, "# text = %s\n", strings.ReplaceAll(sentence.Text, "\n", " "))
+
+		for j, word := range sentence.Words {
+			a := word.Annotation(mapping)
+			misc := []string{fmt.Sprintf("TokenRange=%d:%d", word.Token.Start, word.Token.End)}
+			if j < len(sentence.Words)-1 && sentence.Words[j+1].Token.Start == word.Token.End {
+				misc = append(misc, "SpaceAfter=No")
+			}
+			if best := word.Best(); best != nil {
+				misc = append(misc, "Analysis="+escapeMisc(best.FormatString()))
+			}
+			fmt.Fprintf(bw, "%d\t%s\t%s\t%s\t%s\t%s\t_\t_\t_\t%s\n", j+1, word.Token.Content,
+				conlluField(a.Lemma), conlluField(a.UPOS), conlluField(a.XPOS), a.Feats, strings.Join(misc, "|"))
+		}
+		bw.WriteString("\n")
+	}
+	return bw.Flush()
+}
+
+func conlluField(s string) string {
+	if s == "" {
+		return "_"
+	}
+	return s
+}
+
+var (
+	miscEscaper   = strings.NewReplacer("%", "%25", "|", "%7C", "=", "%3D", " ", "%20", "\t", "%09")
+	miscUnescaper = strings.NewReplacer("%25", "%", "%7C", "|", "%3D", "=", "%20", " ", "%09", "\t")
+)
+
+func escapeMisc(s string) string {
+	return miscEscaper.Replace(s)
+}
+
+func unescapeMisc(s string) string {
+	return miscUnescaper.Replace(s)
+}
+
+// ReadCoNLLU reads a CoNLL-U document. The LEMMA, UPOS, XPOS and FEATS
+// columns are kept in Word.UD. If lex is not nil, Analysis entries in MISC
+// are parsed into selected analyses. Multiword token and empty node lines are
+// skipped. Token types are detected from the word forms.
+func ReadCoNLLU(r io.Reader, lex *lexicon.RootLexicon) (*Document, error) {
+	doc := &Document{}
+	var sentence *Sentence
+	start := 0
+	finish := func() {
+		if sentence != nil {
+			if sentence.Text == "" {
+				sentence.Text = sentenceText(sentence.Words)
+			}
+			sentence.setOffsets()
+			doc.Sentences = append(doc.Sentences, sentence)
+			start += len([]rune(sentence.Text)) + 1
+			sentence = nil
+		}
+	}
+
+	scanner := bufio.NewScanner(r)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if strings.TrimSpace(line) == "" {
+			finish()
+			continue
+		}
+		if sentence == nil {
+			sentence = &Sentence{Start: start}
+		}
+
+		if strings.HasPrefix(line, "#") {
+			key, value, ok := strings.Cut(strings.TrimSpace(line[1:]), "=")
+			if ok {
+				switch strings.TrimSpace(key) {
+				case "sent_id":
+					sentence.ID = strings.TrimSpace(value)
+				case "text":
+					sentence.Text = strings.TrimSpace(value)
+				}
+			}
+			continue
+		}
+
+		// Tokens without TokenRange are assumed to be separated by spaces
+		offset := 0
+		if n := len(sentence.Words); n > 0 {
+			offset = sentence.Words[n-1].Token.End + 1
+		}
+		word, err := parseCoNLLUWord(line, offset, lex)
+		if err != nil {
+			return nil, fmt.Errorf("corpus: line %d: %w", lineNumber, err)
+		}
+		if word != nil {
+			sentence.Words = append(sentence.Words, word)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	finish()
+	return doc, nil
+}
+
+// sentenceText rebuilds the text of a sentence from token offsets
+func sentenceText(words []*Word) string {
+	var sb strings.Builder
+	pos := 0
+	for _, word := range words {
+		for ; pos < word.Token.Start; pos++ {
+			sb.WriteString(" ")
+		}
+		sb.WriteString(word.Token.Content)
+		pos = word.Token.End
+	}
+	return sb.String()
+}
+
+func parseCoNLLUWord(line string, offset int, lex *lexicon.RootLexicon) (*Word, error) {
+	fields := strings.Split(line, "\t")
+	if len(fields) != 10 {
+		return nil, fmt.Errorf("expected 10 columns, got %d", len(fields))
+	}
+	if strings.ContainsAny(fields[0], "-.") {
+		return nil, nil
+	}
+
+	feats, err := ud.ParseFeatures(fields[5])
+	if err != nil {
+		return nil, err
+	}
+	word := &Word{Selected: -1, UD: &ud.Annotation{
+		Lemma: fields[2],
+		UPOS:  fields[3],
+		XPOS:  fields[4],
+		Feats: feats,
+	}}
+
+	form := fields[1]
+	start, end := -1, -1
+	if fields[9] != "_" {
+		for _, item := range strings.Split(fields[9], "|") {
+			key, value, _ := strings.Cut(item, "=")
+			switch key {
+			case "TokenRange":
+				s, e, ok := strings.Cut(value, ":")
+				start, err = strconv.Atoi(s)
+				if err == nil {
+					end, err = strconv.Atoi(e)
+				}
+				if !ok || err != nil {
+					return nil, fmt.Errorf("invalid TokenRange %q", value)
+				}
+			case "Analysis":
+				if lex == nil {
+					continue
+				}
+				a, err := analysis.Parse(unescapeMisc(value), lex)
+				if err != nil {
+					return nil, err
+				}
+				word.Analyses = []*analysis.SingleAnalysis{a}
+				word.Selected = 0
+			}
+		}
+	}
+	if start < 0 {
+		start, end = offset, offset+len([]rune(form))
+	}
+	word.Token = tokenization.NewToken(form, tokenization.DetermineTokenType(form), start, end)
+	return word, nil
+}
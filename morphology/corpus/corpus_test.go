@@ -0,0 +1,151 @@
+package corpus
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/kalaomer/zemberek-go/core/turkish"
+	"github.com/kalaomer/zemberek-go/morphology"
+	"github.com/kalaomer/zemberek-go/morphology/lexicon"
+	"github.com/kalaomer/zemberek-go/tokenization"
+)
+
+func testMorphology() *morphology.TurkishMorphology {
+	attrs := map[turkish.RootAttribute]bool{turkish.Voicing: true}
+	lex := lexicon.NewRootLexicon([]*lexicon.DictionaryItem{
+		lexicon.NewDictionaryItem("kitap", "kitap", turkish.Noun, turkish.NonePos, attrs, "", 0),
+		lexicon.NewDictionaryItem("gelmek", "gel", turkish.Verb, turkish.NonePos, nil, "", 0),
+	})
+	return morphology.NewBuilder(lex).Build()
+}
+
+func testDocument(morph *morphology.TurkishMorphology) *Document {
+	doc := Analyze(morph, "Kitaplarımdan geldim.", "Kitabı xyz.")
+	// Disambiguate the first word
+	doc.Sentences[0].Words[0].Selected = 0
+	return doc
+}
+
+func TestWriteCoNLLU(t *testing.T) {
+	morph := testMorphology()
+	var buf bytes.Buffer
+	if err := WriteCoNLLU(&buf, testDocument(morph), nil); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	for _, expected := range []string{
+		"# sent_id = 1\n# text = Kitaplarımdan geldim.\n",
+		"1\tKitaplarımdan\tkitap\tNOUN\tNoun\tCase=Abl|Number=Plur|Number[psor]=Sing|Person=3|Person[psor]=1\t_\t_\t_\t" +
+			"TokenRange=0:13|Analysis=[kitap:Noun]%20kitap:Noun+lar:A3pl+ım:P1sg+dan:Abl\n",
+		"2\tgeldim\tgel\tVERB\tVerb\tEvident=Fh|Number=Sing|Person=1|Polarity=Pos|Tense=Past\t_\t_\t_\tTokenRange=14:20|SpaceAfter=No|",
+		"3\t.\t.\tPUNCT\t_\t_\t_\t_\t_\tTokenRange=20:21\n",
+		"2\txyz\txyz\tX\t_\t_\t_\t_\t_\tTokenRange=7:10|SpaceAfter=No\n",
+	} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("output lacks %q:\n%s", expected, out)
+		}
+	}
+}
+
+func TestCoNLLURoundTrip(t *testing.T) {
+	morph := testMorphology()
+	var first bytes.Buffer
+	if err := WriteCoNLLU(&first, testDocument(morph), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, line := range strings.Split(first.String(), "\n") {
+		if columns := strings.Split(line, "\t"); len(columns) == 10 && strings.Contains(columns[9], " ") {
+			t.Errorf("space in MISC %q", columns[9])
+		}
+	}
+
+	doc, err := ReadCoNLLU(strings.NewReader(first.String()), morph.Lexicon)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(doc.Sentences) != 2 || len(doc.Sentences[0].Words) != 3 || doc.Sentences[1].Start != 22 {
+		t.Fatalf("unexpected document %+v", doc.Sentences)
+	}
+	word := doc.Sentences[0].Words[0]
+	if word.Best() == nil || word.Best().Item.ID != "kitap_Noun" || word.Token.Start != 0 || word.Token.End != 13 {
+		t.Errorf("unexpected word %+v", word)
+	}
+	if doc.Sentences[0].Words[2].Token.Type != tokenization.Punctuation {
+		t.Error("token type not detected")
+	}
+
+	var second bytes.Buffer
+	if err := WriteCoNLLU(&second, doc, nil); err != nil {
+		t.Fatal(err)
+	}
+	if first.String() != second.String() {
+		t.Errorf("round trip differs:\n%s\n%s", first.String(), second.String())
+	}
+}
+
+func TestReadCoNLLUWithoutMisc(t *testing.T) {
+	input := "# sent_id = a\n" +
+		"1-2\tgeldimse\t_\t_\t_\t_\t_\t_\t_\t_\n" +
+		"1\tgeldim\tgel\tVERB\t_\tTense=Past\t0\troot\t_\t_\n" +
+		"2\tse\tse\tAUX\t_\t_\t1\tcop\t_\tSpaceAfter=No\n" +
+		"3\t.\t.\tPUNCT\t_\t_\t1\tpunct\t_\t_\n"
+	doc, err := ReadCoNLLU(strings.NewReader(input), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := doc.Sentences[0]
+	if s.ID != "a" || len(s.Words) != 3 || s.Text != "geldim se ." {
+		t.Fatalf("unexpected sentence %+v", s)
+	}
+	if s.Words[0].UD.Lemma != "gel" || s.Words[0].UD.Feats["Tense"] != "Past" || s.Words[1].Token.Start != 7 {
+		t.Errorf("unexpected words %+v %+v", s.Words[0].UD, s.Words[1].Token)
+	}
+
+	if _, err := ReadCoNLLU(strings.NewReader("1\tgeldim\tgel\n"), nil); err == nil {
+		t.Error("expected error for missing columns")
+	}
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	morph := testMorphology()
+	original := testDocument(morph)
+	var first bytes.Buffer
+	if err := WriteJSON(&first, original, nil); err != nil {
+		t.Fatal(err)
+	}
+	out := first.String()
+	for _, expected := range []string{`"selected": 0`, `"selected": -1`, `"type": "Punctuation"`,
+		`"analysis": "[kitap:Noun] kitap:Noun+lar:A3pl+ım:P1sg+dan:Abl"`, `"id": "P1sg"`, `"surface": "ım"`} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("JSON lacks %q:\n%s", expected, out)
+		}
+	}
+
+	doc, err := ReadJSON(strings.NewReader(out), morph.Lexicon)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, s := range doc.Sentences {
+		for j, w := range s.Words {
+			o := original.Sentences[i].Words[j]
+			if len(w.Analyses) != len(o.Analyses) || w.Selected != o.Selected || *w.Token != *o.Token {
+				t.Errorf("word %d/%d differs: %+v %+v", i, j, w.Token, o.Token)
+			}
+		}
+	}
+
+	var second bytes.Buffer
+	if err := WriteJSON(&second, doc, nil); err != nil {
+		t.Fatal(err)
+	}
+	if second.String() != out {
+		t.Error("JSON round trip differs")
+	}
+
+	if _, err := ReadJSON(strings.NewReader(`{"sentences": [{"tokens": [{"form": "x", "analyses": [{"analysis": "bad"}]}]}]}`),
+		morph.Lexicon); err == nil {
+		t.Error("expected error for invalid analysis")
+	}
+}
@@ -0,0 +1,106 @@
+// Package corpus holds tokenized and analysed documents and reads and writes
+// them as CoNLL-U and JSON.
+package corpus
+
+import (
+	"strconv"
+
+	"github.com/kalaomer/zemberek-go/morphology"
+	"github.com/kalaomer/zemberek-go/morphology/analysis"
+	"github.com/kalaomer/zemberek-go/morphology/ud"
+	"github.com/kalaomer/zemberek-go/tokenization"
+)
+
+// Document is a sequence of analysed sentences
+type Document struct {
+	Sentences []*Sentence
+}
+
+// Sentence is a tokenized sentence. Start is the rune offset of the sentence
+// in its document, token offsets are relative to Text.
+type Sentence struct {
+	ID    string
+	Text  string
+	Start int
+	Words []*Word
+}
+
+// setOffsets sets the byte and UTF-16 offsets of tokens read from files,
+// which only store rune offsets
+func (s *Sentence) setOffsets() {
+	offsets := tokenization.NewOffsetMap(s.Text)
+	for _, word := range s.Words {
+		t := word.Token
+		if t.Start >= 0 && t.Start <= t.End && t.End <= offsets.Len() {
+			t.Span = *offsets.Span(t.Start, t.End)
+		}
+	}
+}
+
+// Word is a token with its candidate analyses
+type Word struct {
+	Token    *tokenization.Token
+	Analyses []*analysis.SingleAnalysis
+	// Selected is the index of the disambiguated analysis, or -1 if the word
+	// is not disambiguated
+	Selected int
+	// UD, if set, is written instead of the annotation computed from the best
+	// analysis. Readers set it when the file carries annotations that cannot
+	// be derived from analyses.
+	UD *ud.Annotation
+}
+
+// NewWord creates a word that is not disambiguated. wa may be nil for tokens
+// that are not analysed.
+func NewWord(token *tokenization.Token, wa *analysis.WordAnalysis) *Word {
+	w := &Word{Token: token, Selected: -1}
+	if wa != nil {
+		w.Analyses = wa.AnalysisResults
+	}
+	return w
+}
+
+// Best returns the selected analysis, or the first one if the word is not
+// disambiguated. Returns nil if the word has no analysis.
+func (w *Word) Best() *analysis.SingleAnalysis {
+	if w.Selected >= 0 && w.Selected < len(w.Analyses) {
+		return w.Analyses[w.Selected]
+	}
+	if len(w.Analyses) > 0 {
+		return w.Analyses[0]
+	}
+	return nil
+}
+
+// Annotation returns the UD annotation of the word. Words without analysis
+// get the token as lemma and PUNCT or X as UPOS.
+func (w *Word) Annotation(mapping *ud.Mapping) *ud.Annotation {
+	if w.UD != nil {
+		return w.UD
+	}
+	if best := w.Best(); best != nil {
+		return mapping.Map(best)
+	}
+	upos := "X"
+	if w.Token.Type == tokenization.Punctuation {
+		upos = "PUNCT"
+	}
+	return &ud.Annotation{Lemma: w.Token.Content, UPOS: upos, XPOS: "_", Feats: ud.Features{}}
+}
+
+// Analyze tokenizes sentences with the default tokenizer and analyses every
+// token. Sentences are assumed to be separated by a single space in the
+// document.
+func Analyze(morph *morphology.TurkishMorphology, sentences ...string) *Document {
+	doc := &Document{}
+	start := 0
+	for i, text := range sentences {
+		sentence := &Sentence{ID: strconv.Itoa(i + 1), Text: text, Start: start}
+		for _, token := range tokenization.DEFAULT.Tokenize(text) {
+			sentence.Words = append(sentence.Words, NewWord(token, morph.Analyze(token.Content)))
+		}
+		doc.Sentences = append(doc.Sentences, sentence)
+		start += len([]rune(text)) + 1
+	}
+	return doc
+}
@@ -0,0 +1,199 @@
+package corpus
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/kalaomer/zemberek-go/morphology/analysis"
+	"github.com/kalaomer/zemberek-go/morphology/lexicon"
+	"github.com/kalaomer/zemberek-go/morphology/ud"
+	"github.com/kalaomer/zemberek-go/tokenization"
+)
+
+type jsonDocument struct {
+	Sentences []jsonSentence `json:"sentences"`
+}
+
+type jsonSentence struct {
+	ID     string      `json:"id,omitempty"`
+	Text   string      `json:"text"`
+	Start  int         `json:"start"`
+	End    int         `json:"end"`
+	Tokens []jsonToken `json:"tokens"`
+}
+
+type jsonToken struct {
+	Form       string         `json:"form"`
+	Normalized string         `json:"normalized,omitempty"`
+	Type       string         `json:"type"`
+	Start      int            `json:"start"`
+	End        int            `json:"end"`
+	Lemma      string         `json:"lemma"`
+	UPOS       string         `json:"upos"`
+	XPOS       string         `json:"xpos"`
+	Feats      string         `json:"feats"`
+	Selected   int            `json:"selected"`
+	Analyses   []jsonAnalysis `json:"analyses"`
+}
+
+type jsonAnalysis struct {
+	Analysis  string         `json:"analysis"`
+	Item      string         `json:"item"`
+	Lemma     string         `json:"lemma"`
+	UPOS      string         `json:"upos"`
+	XPOS      string         `json:"xpos"`
+	Feats     string         `json:"feats"`
+	Morphemes []jsonMorpheme `json:"morphemes"`
+}
+
+type jsonMorpheme struct {
+	ID      string `json:"id"`
+	Surface string `json:"surface"`
+}
+
+// WriteJSON writes doc as indented JSON, including all candidate analyses of
+// every token. UD fields come from mapping, or from ud.DefaultMapping if it
+// is nil.
+//
+// Offsets are half-open rune offsets; sentence offsets are relative to the
+// document, token offsets to the sentence text. "selected" is -1 for words that are not disambiguated. The
+// lemma, upos, xpos and feats of a token describe its best analysis.
+//
+//	{
+//	  "sentences": [{
+//	    "id": "1",
+//	    "text": "Kitaplarımdan geldim.",
+//	    "start": 0,
+//	    "end": 21,
+//	    "tokens": [{
+//	      "form": "Kitaplarımdan",
+//	      "normalized": "Kitaplarımdan",
+//	      "type": "Word",
+//	      "start": 0,
+//	      "end": 13,
+//	      "lemma": "kitap",
+//	      "upos": "NOUN",
+//	      "xpos": "Noun",
+//	      "feats": "Case=Abl|Number=Plur|Number[psor]=Sing|Person=3|Person[psor]=1",
+//	      "selected": -1,
+//	      "analyses": [{
+//	        "analysis": "[kitap:Noun] kitap:Noun+lar:A3pl+ım:P1sg+dan:Abl",
+//	        "item": "kitap_Noun",
+//	        "lemma": "kitap",
+//	        "upos": "NOUN",
+//	        "xpos": "Noun",
+//	        "feats": "Case=Abl|Number=Plur|Number[psor]=Sing|Person=3|Person[psor]=1",
+//	        "morphemes": [
+//	          {"id": "Noun", "surface": "kitap"},
+//	          {"id": "A3pl", "surface": "lar"},
+//	          {"id": "P1sg", "surface": "ım"},
+//	          {"id": "Abl", "surface": "dan"}
+//	        ]
+//	      }]
+//	    }]
+//	  }]
+//	}
+func WriteJSON(w io.Writer, doc *Document, mapping *ud.Mapping) error {
+	if mapping == nil {
+		mapping = ud.DefaultMapping()
+	}
+	out := jsonDocument{Sentences: make([]jsonSentence, 0, len(doc.Sentences))}
+	for _, sentence := range doc.Sentences {
+		js := jsonSentence{
+			ID:     sentence.ID,
+			Text:   sentence.Text,
+			Start:  sentence.Start,
+			End:    sentence.Start + len([]rune(sentence.Text)),
+			Tokens: make([]jsonToken, 0, len(sentence.Words)),
+		}
+		for _, word := range sentence.Words {
+			a := word.Annotation(mapping)
+			jt := jsonToken{
+				Form:       word.Token.Content,
+				Normalized: word.Token.Normalized,
+				Type:       tokenization.TokenTypeName(word.Token.Type),
+				Start:      word.Token.Start,
+				End:        word.Token.End,
+				Lemma:      a.Lemma,
+				UPOS:       a.UPOS,
+				XPOS:       a.XPOS,
+				Feats:      a.Feats.String(),
+				Selected:   word.Selected,
+				Analyses:   make([]jsonAnalysis, 0, len(word.Analyses)),
+			}
+			for _, sa := range word.Analyses {
+				ua := mapping.Map(sa)
+				ja := jsonAnalysis{
+					Analysis:  sa.FormatString(),
+					Item:      sa.Item.ID,
+					Lemma:     ua.Lemma,
+					UPOS:      ua.UPOS,
+					XPOS:      ua.XPOS,
+					Feats:     ua.Feats.String(),
+					Morphemes: make([]jsonMorpheme, len(sa.MorphemeDataList)),
+				}
+				for i, md := range sa.MorphemeDataList {
+					ja.Morphemes[i] = jsonMorpheme{ID: md.Morpheme.ID, Surface: md.Surface}
+				}
+				jt.Analyses = append(jt.Analyses, ja)
+			}
+			js.Tokens = append(js.Tokens, jt)
+		}
+		out.Sentences = append(out.Sentences, js)
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
+
+// ReadJSON reads a document written by WriteJSON. Analyses are parsed from
+// their "analysis" strings using lex. The token level UD fields are kept in
+// Word.UD only for tokens without analyses, as they are derived from the
+// analyses otherwise. If lex is nil, analyses are skipped and every token
+// keeps its UD fields.
+func ReadJSON(r io.Reader, lex *lexicon.RootLexicon) (*Document, error) {
+	var in jsonDocument
+	if err := json.NewDecoder(r).Decode(&in); err != nil {
+		return nil, fmt.Errorf("corpus: %w", err)
+	}
+
+	doc := &Document{}
+	for _, js := range in.Sentences {
+		sentence := &Sentence{ID: js.ID, Text: js.Text, Start: js.Start}
+		for _, jt := range js.Tokens {
+			tokenType, ok := tokenization.TokenTypeFromName(jt.Type)
+			if !ok {
+				tokenType = tokenization.DetermineTokenType(jt.Form)
+			}
+			word := &Word{
+				Token:    tokenization.NewToken(jt.Form, tokenType, jt.Start, jt.End, jt.Normalized),
+				Selected: jt.Selected,
+			}
+			if lex != nil {
+				for _, ja := range jt.Analyses {
+					a, err := analysis.Parse(ja.Analysis, lex)
+					if err != nil {
+						return nil, fmt.Errorf("corpus: sentence %s: %w", js.ID, err)
+					}
+					word.Analyses = append(word.Analyses, a)
+				}
+			}
+			if word.Selected >= len(word.Analyses) {
+				word.Selected = -1
+			}
+			if len(word.Analyses) == 0 {
+				feats, err := ud.ParseFeatures(jt.Feats)
+				if err != nil {
+					return nil, fmt.Errorf("corpus: sentence %s: %w", js.ID, err)
+				}
+				word.UD = &ud.Annotation{Lemma: jt.Lemma, UPOS: jt.UPOS, XPOS: jt.XPOS, Feats: feats}
+			}
+			sentence.Words = append(sentence.Words, word)
+		}
+		sentence.setOffsets()
+		doc.Sentences = append(doc.Sentences, sentence)
+	}
+	return doc, nil
+}
@@ -0,0 +1,152 @@
+// Package expansion expands query terms into their inflected surface forms
+// for search engines that can not stem Turkish words.
+package expansion
+
+import (
+	"github.com/kalaomer/zemberek-go/core/turkish"
+	"github.com/kalaomer/zemberek-go/lm"
+	"github.com/kalaomer/zemberek-go/morphology"
+	"github.com/kalaomer/zemberek-go/morphology/generator"
+	"github.com/kalaomer/zemberek-go/morphology/lexicon"
+	"github.com/kalaomer/zemberek-go/morphology/morphotactics"
+)
+
+// Depth selects which forms are generated
+type Depth int
+
+const (
+	// Inflections generates the inflection tables of lemmas
+	Inflections Depth = iota
+	// Derivations also generates nouns derived from lemmas (gözlük, gelme)
+	// with their number and case forms
+	Derivations
+)
+
+// Expander generates the surface forms of query terms
+type Expander struct {
+	Morphology *morphology.TurkishMorphology
+	Depth      Depth
+	// LM, if set, drops forms that are not in its vocabulary. Forms with a
+	// unigram log probability below MinLogProbability are dropped too, unless
+	// MinLogProbability is zero.
+	LM                lm.LanguageModel
+	MinLogProbability float32
+	// MaxForms limits the forms of a term, unlimited if zero
+	MaxForms int
+}
+
+// NewExpander creates an expander of inflections without frequency cutoff
+func NewExpander(morph *morphology.TurkishMorphology) *Expander {
+	return &Expander{Morphology: morph, Depth: Inflections}
+}
+
+// ExpandWord returns the forms of all lemmas word can be analysed as,
+// starting with word itself. Words without analysis expand to themselves.
+func (e *Expander) ExpandWord(word string) []string {
+	forms := newFormSet(e.MaxForms)
+	forms.add(word)
+	seen := make(map[*lexicon.DictionaryItem]bool)
+	for _, sa := range e.Morphology.Analyze(word).AnalysisResults {
+		if sa.IsUnknown() || seen[sa.Item] {
+			continue
+		}
+		seen[sa.Item] = true
+		e.expand(sa.Item, forms)
+	}
+	return forms.list
+}
+
+// ExpandLemma returns the forms of the dictionary items with lemma and POS
+func (e *Expander) ExpandLemma(lemma string, pos turkish.PrimaryPos) []string {
+	forms := newFormSet(e.MaxForms)
+	for _, item := range e.Morphology.Lexicon.GetItems(lemma) {
+		if item.PrimaryPos == pos {
+			e.expand(item, forms)
+		}
+	}
+	return forms.list
+}
+
+// ExpandItem returns the forms of a dictionary item
+func (e *Expander) ExpandItem(item *lexicon.DictionaryItem) []string {
+	forms := newFormSet(e.MaxForms)
+	e.expand(item, forms)
+	return forms.list
+}
+
+func (e *Expander) expand(item *lexicon.DictionaryItem, forms *formSet) {
+	specs := []*generator.ParadigmSpec{generator.DefaultParadigmSpec(item.PrimaryPos)}
+	if e.Depth >= Derivations {
+		specs = append(specs, derivationSpec(item.PrimaryPos))
+	}
+	for _, spec := range specs {
+		if spec == nil {
+			continue
+		}
+		p, err := e.Morphology.WordGenerator.GenerateParadigm(item, spec)
+		if err != nil {
+			continue
+		}
+		for _, r := range p.Forms() {
+			if e.accept(r.Surface) {
+				forms.add(r.Surface)
+			}
+		}
+	}
+}
+
+func (e *Expander) accept(form string) bool {
+	if e.LM == nil {
+		return true
+	}
+	vocabulary := e.LM.GetVocabulary()
+	index, ok := vocabulary.VocabularyIndexMap[form]
+	if !ok {
+		return false
+	}
+	return e.MinLogProbability == 0 || e.LM.GetProbability([]int{index}) >= e.MinLogProbability
+}
+
+// derivationSpec returns derived nouns of a POS with their number and case
+// forms, or nil if the POS has no derivations
+func derivationSpec(pos turkish.PrimaryPos) *generator.ParadigmSpec {
+	var derivations []*generator.Feature
+	switch pos {
+	case turkish.Noun:
+		derivations = []*generator.Feature{
+			{Name: "Ness", Morphemes: []*morphotactics.Morpheme{morphotactics.A3sg, morphotactics.Ness, morphotactics.Noun}},
+			{Name: "Dim", Morphemes: []*morphotactics.Morpheme{morphotactics.A3sg, morphotactics.Dim, morphotactics.Noun}},
+		}
+	case turkish.Verb:
+		derivations = []*generator.Feature{
+			{Name: "Inf1", Morphemes: []*morphotactics.Morpheme{morphotactics.Inf1, morphotactics.Noun}},
+			{Name: "Inf2", Morphemes: []*morphotactics.Morpheme{morphotactics.Inf2, morphotactics.Noun}},
+			{Name: "PresPart", Morphemes: []*morphotactics.Morpheme{morphotactics.PresPart, morphotactics.Noun}},
+		}
+	default:
+		return nil
+	}
+
+	spec := generator.NounParadigmSpec().Select("Possession", "None")
+	spec.Dimensions = append([]*generator.Dimension{{Name: "Derivation", Features: derivations}}, spec.Dimensions...)
+	return spec
+}
+
+// formSet keeps distinct forms in insertion order
+type formSet struct {
+	list  []string
+	seen  map[string]bool
+	limit int
+}
+
+func newFormSet(limit int) *formSet {
+	return &formSet{seen: make(map[string]bool), limit: limit}
+}
+
+func (fs *formSet) add(form string) {
+	if fs.seen[form] || (fs.limit > 0 && len(fs.list) >= fs.limit) {
+		return
+	}
+	fs.seen[form] = true
+	fs.list = append(fs.list, form)
+}
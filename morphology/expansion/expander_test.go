@@ -0,0 +1,103 @@
+package expansion
+
+import (
+	"testing"
+
+	"github.com/kalaomer/zemberek-go/core/turkish"
+	"github.com/kalaomer/zemberek-go/lm"
+	"github.com/kalaomer/zemberek-go/morphology"
+	"github.com/kalaomer/zemberek-go/morphology/lexicon"
+)
+
+func testMorphology() *morphology.TurkishMorphology {
+	voicing := map[turkish.RootAttribute]bool{turkish.Voicing: true}
+	lex := lexicon.NewRootLexicon([]*lexicon.DictionaryItem{
+		lexicon.NewDictionaryItem("kitap", "kitap", turkish.Noun, turkish.NonePos, voicing, "", 0),
+		lexicon.NewDictionaryItem("göz", "göz", turkish.Noun, turkish.NonePos, nil, "", 0),
+		lexicon.NewDictionaryItem("gelmek", "gel", turkish.Verb, turkish.NonePos, nil, "", 0),
+	})
+	return morphology.NewBuilder(lex).Build()
+}
+
+func contains(forms []string, form string) bool {
+	for _, f := range forms {
+		if f == form {
+			return true
+		}
+	}
+	return false
+}
+
+func TestExpandWord(t *testing.T) {
+	e := NewExpander(testMorphology())
+	forms := e.ExpandWord("kitabımda")
+	if len(forms) == 0 || forms[0] != "kitabımda" {
+		t.Fatalf("forms should start with the word: %v", forms)
+	}
+	for _, want := range []string{"kitap", "kitaplar", "kitabı", "kitaba", "kitaplarımızdan"} {
+		if !contains(forms, want) {
+			t.Errorf("%q missing in %v", want, forms)
+		}
+	}
+	if contains(forms, "kitaplık") {
+		t.Errorf("derivations should not be generated with Inflections: %v", forms)
+	}
+
+	if forms := e.ExpandWord("xyzq"); len(forms) != 1 || forms[0] != "xyzq" {
+		t.Errorf("unknown word should expand to itself: %v", forms)
+	}
+}
+
+func TestExpandDerivations(t *testing.T) {
+	e := NewExpander(testMorphology())
+	e.Depth = Derivations
+	nouns := e.ExpandLemma("göz", turkish.Noun)
+	for _, want := range []string{"gözler", "gözlük", "gözlüklerden", "gözcük"} {
+		if !contains(nouns, want) {
+			t.Errorf("%q missing in %v", want, nouns)
+		}
+	}
+	verbs := e.ExpandLemma("gelmek", turkish.Verb)
+	for _, want := range []string{"geldi", "gelmeyecek", "gelme", "gelmek", "gelenler"} {
+		if !contains(verbs, want) {
+			t.Errorf("%q missing in %v", want, verbs)
+		}
+	}
+}
+
+func TestExpandCutoff(t *testing.T) {
+	model := lm.NewSimpleLM(1)
+	for i, w := range []string{"kitap", "kitaplar", "kitaba"} {
+		model.Vocabulary.Vocabulary = append(model.Vocabulary.Vocabulary, w)
+		model.Vocabulary.VocabularyIndexMap[w] = i
+	}
+	e := NewExpander(testMorphology())
+	e.LM = model
+	forms := e.ExpandLemma("kitap", turkish.Noun)
+	if len(forms) != 3 {
+		t.Errorf("expected only vocabulary forms, got %v", forms)
+	}
+	e.MinLogProbability = -1
+	if forms := e.ExpandLemma("kitap", turkish.Noun); len(forms) != 0 {
+		t.Errorf("expected no forms above cutoff, got %v", forms)
+	}
+
+	e.LM = nil
+	e.MaxForms = 5
+	if forms := e.ExpandLemma("kitap", turkish.Noun); len(forms) != 5 {
+		t.Errorf("expected 5 forms, got %v", forms)
+	}
+}
+
+func TestQueries(t *testing.T) {
+	forms := []string{"kitap", "kitap'a", `a"b`}
+	if got, want := FTS5Query(forms), `"kitap" OR "kitap'a" OR "a""b"`; got != want {
+		t.Errorf("FTS5Query = %s, want %s", got, want)
+	}
+	if got, want := LuceneQuery("title", []string{"kitap", "a:b"}), `title:(kitap OR a\:b)`; got != want {
+		t.Errorf("LuceneQuery = %s, want %s", got, want)
+	}
+	if got, want := LuceneQuery("", []string{"kitap"}), `(kitap)`; got != want {
+		t.Errorf("LuceneQuery = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,43 @@
+package expansion
+
+import "strings"
+
+// FTS5Query returns forms as an SQLite FTS5 MATCH expression, e.g.
+// "kitap" OR "kitaplar". Forms are quoted as strings so that FTS5 operators
+// and punctuation in them are not interpreted.
+func FTS5Query(forms []string) string {
+	quoted := make([]string, len(forms))
+	for i, f := range forms {
+		quoted[i] = `"` + strings.ReplaceAll(f, `"`, `""`) + `"`
+	}
+	return strings.Join(quoted, " OR ")
+}
+
+// luceneSpecial are the characters escaped in Lucene query strings
+const luceneSpecial = `+-&|!(){}[]^"~*?:\/`
+
+// LuceneQuery returns forms as a Lucene query string, e.g.
+// title:(kitap OR kitaplar). Without a field the terms are searched in the
+// default field.
+func LuceneQuery(field string, forms []string) string {
+	escaped := make([]string, len(forms))
+	for i, f := range forms {
+		escaped[i] = escapeLucene(f)
+	}
+	query := "(" + strings.Join(escaped, " OR ") + ")"
+	if field != "" {
+		query = escapeLucene(field) + ":" + query
+	}
+	return query
+}
+
+func escapeLucene(s string) string {
+	var sb strings.Builder
+	for _, r := range s {
+		if strings.ContainsRune(luceneSpecial, r) || r == ' ' {
+			sb.WriteByte('\\')
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
@@ -0,0 +1,12 @@
+package generator
+
+import "github.com/kalaomer/zemberek-go/morphology/analysis"
+
+// InformalAnalysisConverter converts informal morphemes to formal ones. It
+// is an alias of analysis.InformalAnalysisConverter.
+type InformalAnalysisConverter = analysis.InformalAnalysisConverter
+
+// NewInformalAnalysisConverter creates a new converter generating with gen
+func NewInformalAnalysisConverter(gen *WordGenerator) *InformalAnalysisConverter {
+	return analysis.NewInformalAnalysisConverter(gen)
+}
@@ -0,0 +1,291 @@
+package generator
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/kalaomer/zemberek-go/core/turkish"
+	"github.com/kalaomer/zemberek-go/morphology/lexicon"
+	"github.com/kalaomer/zemberek-go/morphology/morphotactics"
+)
+
+// DefaultMaxCells is the cell limit of paradigm specs that do not set one
+const DefaultMaxCells = 2000
+
+// Feature is a value of a paradigm dimension, e.g. the dative case. It adds
+// Morphemes to the generated forms; unmarked values have none.
+type Feature struct {
+	Name      string
+	Morphemes []*morphotactics.Morpheme
+}
+
+// Dimension is an axis of a paradigm table, e.g. case
+type Dimension struct {
+	Name     string
+	Features []*Feature
+}
+
+// ParadigmSpec defines the feature space of a paradigm. Cells are the cross
+// product of the dimension features, and the morphemes of a cell are the
+// morphemes of its features in dimension order, so dimensions must be listed
+// in morpheme order.
+type ParadigmSpec struct {
+	Dimensions []*Dimension
+	// MaxCells bounds the size of the table, DefaultMaxCells if zero
+	MaxCells int
+}
+
+// Select returns a copy of the spec restricted to the named features of a
+// dimension, e.g. Select("Case", "Nom", "Dat"). Unknown names are ignored.
+func (s *ParadigmSpec) Select(dimension string, features ...string) *ParadigmSpec {
+	result := &ParadigmSpec{MaxCells: s.MaxCells, Dimensions: make([]*Dimension, len(s.Dimensions))}
+	for i, d := range s.Dimensions {
+		if d.Name != dimension {
+			result.Dimensions[i] = d
+			continue
+		}
+		selected := &Dimension{Name: d.Name}
+		for _, f := range d.Features {
+			for _, name := range features {
+				if f.Name == name {
+					selected.Features = append(selected.Features, f)
+					break
+				}
+			}
+		}
+		result.Dimensions[i] = selected
+	}
+	return result
+}
+
+// Without returns a copy of the spec without the named dimensions
+func (s *ParadigmSpec) Without(dimensions ...string) *ParadigmSpec {
+	result := &ParadigmSpec{MaxCells: s.MaxCells}
+	for _, d := range s.Dimensions {
+		removed := false
+		for _, name := range dimensions {
+			if d.Name == name {
+				removed = true
+				break
+			}
+		}
+		if !removed {
+			result.Dimensions = append(result.Dimensions, d)
+		}
+	}
+	return result
+}
+
+// Size returns the number of cells of the spec
+func (s *ParadigmSpec) Size() int {
+	size := 1
+	for _, d := range s.Dimensions {
+		size *= len(d.Features)
+	}
+	return size
+}
+
+func feature(name string, morphemes ...*morphotactics.Morpheme) *Feature {
+	return &Feature{Name: name, Morphemes: morphemes}
+}
+
+// NounParadigmSpec returns number × possession × case
+func NounParadigmSpec() *ParadigmSpec {
+	return &ParadigmSpec{Dimensions: []*Dimension{
+		{Name: "Number", Features: []*Feature{
+			feature("Sg", morphotactics.A3sg), feature("Pl", morphotactics.A3pl),
+		}},
+		{Name: "Possession", Features: []*Feature{
+			feature("None", morphotactics.Pnon),
+			feature("1Sg", morphotactics.P1sg), feature("2Sg", morphotactics.P2sg), feature("3Sg", morphotactics.P3sg),
+			feature("1Pl", morphotactics.P1pl), feature("2Pl", morphotactics.P2pl), feature("3Pl", morphotactics.P3pl),
+		}},
+		{Name: "Case", Features: []*Feature{
+			feature("Nom", morphotactics.Nom), feature("Acc", morphotactics.Acc), feature("Dat", morphotactics.Dat),
+			feature("Loc", morphotactics.Loc), feature("Abl", morphotactics.Abl), feature("Gen", morphotactics.Gen),
+			feature("Ins", morphotactics.Ins), feature("Equ", morphotactics.Equ),
+		}},
+	}}
+}
+
+// VerbParadigmSpec returns polarity × tense, aspect and mood × copula ×
+// person. Cells the morphotactics can not produce are left empty.
+func VerbParadigmSpec() *ParadigmSpec {
+	return &ParadigmSpec{Dimensions: []*Dimension{
+		{Name: "Polarity", Features: []*Feature{
+			feature("Pos"), feature("Neg", morphotactics.Neg),
+		}},
+		{Name: "Tense", Features: []*Feature{
+			feature("Past", morphotactics.Past), feature("Narr", morphotactics.Narr),
+			feature("Prog", morphotactics.Prog1), feature("Aor", morphotactics.Aor),
+			feature("Fut", morphotactics.Fut), feature("Cond", morphotactics.Cond),
+			feature("Opt", morphotactics.Opt), feature("Neces", morphotactics.Neces),
+		}},
+		{Name: "Copula", Features: []*Feature{
+			feature("None"), feature("Past", morphotactics.Past),
+			feature("Narr", morphotactics.Narr), feature("Cond", morphotactics.Cond),
+		}},
+		{Name: "Person", Features: []*Feature{
+			feature("1Sg", morphotactics.A1sg), feature("2Sg", morphotactics.A2sg), feature("3Sg", morphotactics.A3sg),
+			feature("1Pl", morphotactics.A1pl), feature("2Pl", morphotactics.A2pl), feature("3Pl", morphotactics.A3pl),
+		}},
+	}}
+}
+
+// DefaultParadigmSpec returns the paradigm spec for a primary POS. Returns
+// nil for POS without inflection tables.
+func DefaultParadigmSpec(pos turkish.PrimaryPos) *ParadigmSpec {
+	switch pos {
+	case turkish.Noun:
+		return NounParadigmSpec()
+	case turkish.Verb:
+		return VerbParadigmSpec()
+	}
+	return nil
+}
+
+// ParadigmCell is a cell of a paradigm table
+type ParadigmCell struct {
+	// Features has one feature for every dimension of the spec
+	Features  []*Feature
+	Morphemes []*morphotactics.Morpheme
+	Results   []*Result
+}
+
+// Key returns the feature names of the cell joined with "+", e.g. "Pl+1Sg+Dat"
+func (c *ParadigmCell) Key() string {
+	names := make([]string, len(c.Features))
+	for i, f := range c.Features {
+		names[i] = f.Name
+	}
+	return strings.Join(names, "+")
+}
+
+// Surfaces returns the distinct surface forms of the cell
+func (c *ParadigmCell) Surfaces() []string {
+	surfaces := make([]string, 0, len(c.Results))
+	for _, r := range c.Results {
+		if !containsSurface(surfaces, r.Surface) {
+			surfaces = append(surfaces, r.Surface)
+		}
+	}
+	return surfaces
+}
+
+func containsSurface(list []string, s string) bool {
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
+
+// Paradigm is the inflection table of a dictionary item
+type Paradigm struct {
+	Item  *lexicon.DictionaryItem
+	Spec  *ParadigmSpec
+	Cells []*ParadigmCell
+}
+
+// Cell returns the cell with the given feature names, one per dimension.
+// Returns nil if there is no such cell.
+func (p *Paradigm) Cell(features ...string) *ParadigmCell {
+	key := strings.Join(features, "+")
+	for _, c := range p.Cells {
+		if c.Key() == key {
+			return c
+		}
+	}
+	return nil
+}
+
+// Forms returns the generated forms of all cells in table order
+func (p *Paradigm) Forms() []*Result {
+	forms := make([]*Result, 0, len(p.Cells))
+	for _, c := range p.Cells {
+		forms = append(forms, c.Results...)
+	}
+	return forms
+}
+
+// Missing returns the cells without generated forms
+func (p *Paradigm) Missing() []*ParadigmCell {
+	missing := make([]*ParadigmCell, 0)
+	for _, c := range p.Cells {
+		if len(c.Results) == 0 {
+			missing = append(missing, c)
+		}
+	}
+	return missing
+}
+
+// WriteTo writes the table as tab separated lines of feature names followed by
+// surface forms joined with "/". Empty cells are written as "-".
+func (p *Paradigm) WriteTo(w io.Writer) (int64, error) {
+	var total int64
+	names := make([]string, len(p.Spec.Dimensions))
+	for i, d := range p.Spec.Dimensions {
+		names[i] = d.Name
+	}
+	n, err := fmt.Fprintf(w, "%s\tForm\n", strings.Join(names, "\t"))
+	total += int64(n)
+	if err != nil {
+		return total, err
+	}
+	for _, c := range p.Cells {
+		form := "-"
+		if surfaces := c.Surfaces(); len(surfaces) > 0 {
+			form = strings.Join(surfaces, "/")
+		}
+		n, err = fmt.Fprintf(w, "%s\t%s\n", strings.ReplaceAll(c.Key(), "+", "\t"), form)
+		total += int64(n)
+		if err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
+
+// GenerateParadigm generates the inflection table of item. If spec is nil the
+// default spec of the item's POS is used. Returns an error if there is no
+// default spec or the spec has more cells than its limit.
+func (wg *WordGenerator) GenerateParadigm(item *lexicon.DictionaryItem, spec *ParadigmSpec) (*Paradigm, error) {
+	if spec == nil {
+		spec = DefaultParadigmSpec(item.PrimaryPos)
+		if spec == nil {
+			return nil, fmt.Errorf("generator: no paradigm for %s", item.ID)
+		}
+	}
+	maxCells := spec.MaxCells
+	if maxCells <= 0 {
+		maxCells = DefaultMaxCells
+	}
+	if size := spec.Size(); size > maxCells {
+		return nil, fmt.Errorf("generator: paradigm of %s has %d cells, limit is %d", item.ID, size, maxCells)
+	}
+
+	paradigm := &Paradigm{Item: item, Spec: spec}
+	features := make([]*Feature, len(spec.Dimensions))
+	var fill func(dimension int)
+	fill = func(dimension int) {
+		if dimension == len(spec.Dimensions) {
+			cell := &ParadigmCell{Features: append([]*Feature{}, features...)}
+			for _, f := range features {
+				cell.Morphemes = append(cell.Morphemes, f.Morphemes...)
+			}
+			cell.Results = wg.Generate(item, cell.Morphemes)
+			paradigm.Cells = append(paradigm.Cells, cell)
+			return
+		}
+		for _, f := range spec.Dimensions[dimension].Features {
+			features[dimension] = f
+			fill(dimension + 1)
+		}
+	}
+	if spec.Size() > 0 {
+		fill(0)
+	}
+	return paradigm, nil
+}
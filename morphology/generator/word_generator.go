@@ -1,82 +1,112 @@
 package generator
 
 import (
+	"strings"
+
+	"github.com/kalaomer/zemberek-go/core/turkish"
+	"github.com/kalaomer/zemberek-go/morphology/analysis"
 	"github.com/kalaomer/zemberek-go/morphology/lexicon"
 	"github.com/kalaomer/zemberek-go/morphology/morphotactics"
 )
 
 // WordGenerator generates words from morphological specifications
 type WordGenerator struct {
-	Morphotactics   interface{} // Would be TurkishMorphotactics
-	StemTransitions interface{} // Stem transition provider
+	Morphotactics   *morphotactics.TurkishMorphotactics
+	StemTransitions *morphotactics.StemTransitionsMapBased
 }
 
-// Result represents a generation result
-type Result struct {
-	Surface  string
-	Analysis interface{} // *analysis.SingleAnalysis (avoid import cycle)
-}
+// Result represents a generation result. It is an alias of
+// analysis.GenerationResult, so that WordGenerator implements
+// analysis.WordGenerator.
+type Result = analysis.GenerationResult
 
 // NewResult creates a new Result
-func NewResult(surface string, analysis interface{}) *Result {
+func NewResult(surface string, analysis *analysis.SingleAnalysis) *Result {
 	return &Result{
 		Surface:  surface,
 		Analysis: analysis,
 	}
 }
 
-// String returns string representation
-func (r *Result) String() string {
-	if r.Analysis != nil {
-		return r.Surface + "-" + r.Analysis.(interface{ String() string }).String()
-	}
-	return r.Surface
-}
-
-// GenerationPath represents a path during generation
+// GenerationPath represents a path during generation. Morphemes are the
+// morphemes that are not generated yet.
 type GenerationPath struct {
-	Path      interface{} // *analysis.SearchPath (avoid import cycle)
+	Path      *analysis.SearchPath
 	Morphemes []*morphotactics.Morpheme
 }
 
 // NewGenerationPath creates a new GenerationPath
-func NewGenerationPath(path interface{}, morphemes []*morphotactics.Morpheme) *GenerationPath {
+func NewGenerationPath(path *analysis.SearchPath, morphemes []*morphotactics.Morpheme) *GenerationPath {
 	return &GenerationPath{
 		Path:      path,
 		Morphemes: morphemes,
 	}
 }
 
-// Copy creates a copy with a new path
-func (gp *GenerationPath) Copy(path interface{}) *GenerationPath {
-	// Simplified - avoid type assertion complications
+// Copy creates a copy advanced with surfaceNode. The first remaining morpheme
+// is consumed if surfaceNode produces it.
+func (gp *GenerationPath) Copy(surfaceNode *analysis.SurfaceTransition,
+	phoneticAttributes map[turkish.PhoneticAttribute]bool) *GenerationPath {
+
+	path := gp.Path.GetCopyForGeneration(surfaceNode, phoneticAttributes)
+	if len(gp.Morphemes) > 0 && sameMorpheme(surfaceNode.GetMorpheme(), gp.Morphemes[0]) {
+		return NewGenerationPath(path, gp.Morphemes[1:])
+	}
 	return NewGenerationPath(path, gp.Morphemes)
 }
 
-// Matches checks if a transition matches
-func (gp *GenerationPath) Matches(transition morphotactics.MorphemeTransition) bool {
-	// Simple implementation - needs to be expanded
-	if !transition.HasSurfaceForm() {
+// Matches checks if a transition can be taken for the remaining morphemes.
+// Transitions must produce the next morpheme, except for empty Pnon and Nom
+// transitions as analyses do not contain these morphemes.
+func (gp *GenerationPath) Matches(transition *morphotactics.SuffixTransition) bool {
+	morpheme := transition.GetMorpheme()
+	if len(gp.Morphemes) > 0 && sameMorpheme(morpheme, gp.Morphemes[0]) {
 		return true
 	}
-	// Would need to check morpheme matching
-	return len(gp.Morphemes) > 0
+	return !transition.HasSurfaceForm() && isImplicit(morpheme)
+}
+
+func sameMorpheme(a, b *morphotactics.Morpheme) bool {
+	return a != nil && b != nil && a.ID == b.ID
+}
+
+func isImplicit(m *morphotactics.Morpheme) bool {
+	return m != nil && (m.ID == "Pnon" || m.ID == "Nom")
 }
 
 // NewWordGenerator creates a new WordGenerator
-func NewWordGenerator(morphotactics interface{}) *WordGenerator {
+func NewWordGenerator(morphotactics *morphotactics.TurkishMorphotactics) *WordGenerator {
 	return &WordGenerator{
-		Morphotactics: morphotactics,
+		Morphotactics:   morphotactics,
+		StemTransitions: morphotactics.GetStemTransitions(),
 	}
 }
 
-// Generate generates word forms
+// Generate generates the word forms of item with the given morphemes, e.g.
+// kitap with [Noun, A3pl, P1sg, Abl] generates "kitaplarımdan". The root
+// morpheme (Noun) and the Pnon and Nom morphemes may be omitted, so the
+// morphemes of an analysis can be given as they are.
 func (wg *WordGenerator) Generate(item *lexicon.DictionaryItem, morphemes []*morphotactics.Morpheme) []*Result {
-	// Simplified implementation - full version would need TurkishMorphotactics integration
-	results := make([]*Result, 0)
+	candidates := wg.StemTransitions.GetTransitionsForItem(item)
+
+	paths := make([]*GenerationPath, 0, len(candidates))
+	for _, candidate := range candidates {
+		searchPath := analysis.InitialPath(candidate, "")
+		morphemesInPath := morphemes
+		if len(morphemes) > 0 && sameMorpheme(morphemes[0], searchPath.CurrentState.Morpheme) {
+			morphemesInPath = morphemes[1:]
+		}
+		paths = append(paths, NewGenerationPath(searchPath, morphemesInPath))
+	}
 
-	// Would create stem transitions and search graph
-	// For now, return empty results
+	results := make([]*Result, 0)
+	for _, path := range wg.Search(paths) {
+		var sb strings.Builder
+		for _, transition := range path.Path.Transitions {
+			sb.WriteString(transition.Surface)
+		}
+		results = append(results, NewResult(sb.String(), analysis.FromSearchPath(path.Path)))
+	}
 	return results
 }
 
@@ -88,10 +118,12 @@ func (wg *WordGenerator) Search(currentPaths []*GenerationPath) []*GenerationPat
 		allNewPaths := make([]*GenerationPath, 0)
 
 		for _, path := range currentPaths {
+			// If all morphemes are generated and path is terminal, add to results
 			if len(path.Morphemes) == 0 {
-				// Simplified terminal check
-				result = append(result, path)
-				continue
+				if path.Path.Terminal && !path.Path.PhoneticAttributes[turkish.CannotTerminate] {
+					result = append(result, path)
+					continue
+				}
 			}
 
 			newPaths := wg.Advance(path)
@@ -106,10 +138,49 @@ func (wg *WordGenerator) Search(currentPaths []*GenerationPath) []*GenerationPat
 
 // Advance advances a generation path
 func (wg *WordGenerator) Advance(gPath *GenerationPath) []*GenerationPath {
-	newPaths := make([]*GenerationPath, 0)
+	newPaths := make([]*GenerationPath, 0, 2)
+
+	for _, transition := range gPath.Path.CurrentState.Outgoing {
+		suffixTransition, ok := transition.(*morphotactics.SuffixTransition)
+		if !ok {
+			continue
+		}
+
+		// If there are no more morphemes, only empty transitions may follow
+		if len(gPath.Morphemes) == 0 && suffixTransition.HasSurfaceForm() {
+			continue
+		}
+
+		if !gPath.Matches(suffixTransition) || !suffixTransition.CanPass(gPath.Path) {
+			continue
+		}
 
-	// Would iterate over transitions and create new paths
-	// Simplified implementation
+		// Epsilon (empty) transition - use existing attributes
+		if !suffixTransition.HasSurfaceForm() {
+			newPaths = append(newPaths, gPath.Copy(analysis.NewSurfaceTransition("", suffixTransition),
+				gPath.Path.PhoneticAttributes))
+			continue
+		}
+
+		surface := analysis.GenerateSurface(suffixTransition, gPath.Path.PhoneticAttributes)
+		attributes := analysis.GetMorphemicAttributes(surface, gPath.Path.PhoneticAttributes)
+
+		// Remove CannotTerminate
+		delete(attributes, turkish.CannotTerminate)
+
+		// Handle last token types
+		lastToken := suffixTransition.GetLastTemplateToken()
+		if lastToken != nil {
+			if lastToken.Type == morphotactics.LAST_VOICED {
+				attributes[turkish.ExpectsConsonant] = true
+			} else if lastToken.Type == morphotactics.LAST_NOT_VOICED {
+				attributes[turkish.ExpectsVowel] = true
+				attributes[turkish.CannotTerminate] = true
+			}
+		}
+
+		newPaths = append(newPaths, gPath.Copy(analysis.NewSurfaceTransition(surface, suffixTransition), attributes))
+	}
 
 	return newPaths
 }
@@ -0,0 +1,33 @@
+package morphology
+
+import (
+	"testing"
+
+	"github.com/kalaomer/zemberek-go/morphology/analysis"
+	"github.com/kalaomer/zemberek-go/morphology/generator"
+	"github.com/kalaomer/zemberek-go/morphology/morphotactics"
+)
+
+func TestInformalAnalysisConverter(t *testing.T) {
+	morph := testMorphology()
+	a := findAnalysis(t, morph, "evler", "[ev:Noun] ev:Noun+ler:A3pl")
+
+	// the converter keeps its constructor in both packages
+	converters := map[string]*analysis.InformalAnalysisConverter{
+		"analysis":  analysis.NewInformalAnalysisConverter(morph.WordGenerator),
+		"generator": generator.NewInformalAnalysisConverter(morph.WordGenerator),
+	}
+	for name, converter := range converters {
+		if result := converter.Convert("evler", a); result == nil || result.Surface != "evler" || result.Analysis != a {
+			t.Errorf("%s: formal evler converted to %v", name, result)
+		}
+		morphemes := converter.ToFormalMorphemeNames(a)
+		if len(morphemes) != 2 || morphemes[0] != morphotactics.Noun || morphemes[1] != morphotactics.A3pl {
+			t.Errorf("%s: morphemes of evler are %v", name, morphemes)
+		}
+		var result *generator.Result = converter.Generator.Generate(a.Item, morphemes)[0]
+		if result.Surface != "evler" {
+			t.Errorf("%s: generated %s", name, result.Surface)
+		}
+	}
+}
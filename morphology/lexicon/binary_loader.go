@@ -2,7 +2,9 @@ package lexicon
 
 import (
 	_ "embed"
-	
+	"fmt"
+	"io/fs"
+
 	"github.com/kalaomer/zemberek-go/core/turkish"
 	pb "github.com/kalaomer/zemberek-go/morphology/lexicon/proto"
 	"google.golang.org/protobuf/proto"
@@ -11,16 +13,41 @@ import (
 //go:embed data/lexicon.bin
 var lexiconBinData []byte
 
+// BinaryLexiconName is the default name of the binary lexicon inside a resource bundle
+const BinaryLexiconName = "lexicon.bin"
+
 // GetLexiconBinData returns the embedded binary data for debugging
 func GetLexiconBinData() []byte {
 	return lexiconBinData
 }
 
-// LoadBinaryLexicon loads the binary lexicon file (lexicon.bin)
+// LoadBinaryLexicon loads the embedded binary lexicon file (lexicon.bin)
 func LoadBinaryLexicon() ([]*DictionaryItem, error) {
+	return ParseBinaryLexicon(lexiconBinData)
+}
+
+// LoadBinaryLexiconFS loads a binary lexicon from name inside fsys.
+// Unlike the embedded default, a missing or unreadable file is an error.
+func LoadBinaryLexiconFS(fsys fs.FS, name string) ([]*DictionaryItem, error) {
+	if name == "" {
+		name = BinaryLexiconName
+	}
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return nil, fmt.Errorf("lexicon: read %s: %w", name, err)
+	}
+	items, err := ParseBinaryLexicon(data)
+	if err != nil {
+		return nil, fmt.Errorf("lexicon: parse %s: %w", name, err)
+	}
+	return items, nil
+}
+
+// ParseBinaryLexicon parses protobuf encoded lexicon data
+func ParseBinaryLexicon(data []byte) ([]*DictionaryItem, error) {
 	// Parse protobuf
 	dictionary := &pb.Dictionary{}
-	if err := proto.Unmarshal(lexiconBinData, dictionary); err != nil {
+	if err := proto.Unmarshal(data, dictionary); err != nil {
 		return nil, err
 	}
 
@@ -131,6 +158,10 @@ func convertProtoSecondaryPos(pbPos pb.SecondaryPos) turkish.SecondaryPos {
 // convertProtoRootAttribute converts protobuf RootAttribute to turkish.RootAttribute
 func convertProtoRootAttribute(pbAttr pb.RootAttribute) turkish.RootAttribute {
 	switch pbAttr {
+	case pb.RootAttribute_Aorist_I:
+		return turkish.AoristI
+	case pb.RootAttribute_Aorist_A:
+		return turkish.AoristA
 	case pb.RootAttribute_Voicing:
 		return turkish.Voicing
 	case pb.RootAttribute_NoVoicing:
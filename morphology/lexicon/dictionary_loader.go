@@ -3,6 +3,8 @@ package lexicon
 import (
 	"bufio"
 	_ "embed"
+	"fmt"
+	"io/fs"
 	"os"
 	"strings"
 
@@ -190,6 +192,10 @@ func parsePrimaryPosFromString(s string) turkish.PrimaryPos {
 // parseRootAttributeFromString parses RootAttribute from string
 func parseRootAttributeFromString(s string) turkish.RootAttribute {
 	switch s {
+	case "Aorist_I":
+		return turkish.AoristI
+	case "Aorist_A":
+		return turkish.AoristA
 	case "Voicing":
 		return turkish.Voicing
 	case "NoVoicing":
@@ -220,6 +226,12 @@ func GetDefaultDictionaryPaths() []string {
 	}
 }
 
+// AbbreviationsDictionary returns the embedded abbreviation dictionary,
+// with pronunciations of abbreviations like "TBMM [Pr:tebeemem; P:Abbrv]"
+func AbbreviationsDictionary() string {
+	return abbreviationsData
+}
+
 // LoadAllDefaultDictionaries loads all default dictionaries from embedded data
 func LoadAllDefaultDictionaries() ([]*DictionaryItem, error) {
 	var allItems []*DictionaryItem
@@ -243,6 +255,20 @@ func LoadAllDefaultDictionaries() ([]*DictionaryItem, error) {
 	return allItems, nil
 }
 
+// LoadDictionariesFS loads text dictionaries with the given names from fsys.
+// Every name must exist; missing files are reported rather than skipped.
+func LoadDictionariesFS(fsys fs.FS, names ...string) ([]*DictionaryItem, error) {
+	var allItems []*DictionaryItem
+	for _, name := range names {
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return nil, fmt.Errorf("lexicon: read %s: %w", name, err)
+		}
+		allItems = append(allItems, ParseDictionaryData(string(data))...)
+	}
+	return allItems, nil
+}
+
 // ParseDictionaryData parses dictionary data from string
 func ParseDictionaryData(data string) []*DictionaryItem {
 	var items []*DictionaryItem
@@ -0,0 +1,184 @@
+package lexicon
+
+import (
+	"iter"
+	"sort"
+	"strings"
+
+	"github.com/kalaomer/zemberek-go/core/turkish"
+)
+
+// lexiconIndex holds secondary indexes of a RootLexicon. Hash indexes are
+// kept up to date by Add; sorted lemma lists for prefix and suffix search
+// are built on first use and dropped whenever an item is added.
+type lexiconIndex struct {
+	items           []*DictionaryItem
+	byPrimaryPos    map[turkish.PrimaryPos][]*DictionaryItem
+	bySecondaryPos  map[turkish.SecondaryPos][]*DictionaryItem
+	byAttribute     map[turkish.RootAttribute][]*DictionaryItem
+	byRoot          map[string][]*DictionaryItem
+	byPronunciation map[string][]*DictionaryItem
+
+	sortedLemmas   []string
+	reversedLemmas []string
+}
+
+func newLexiconIndex() *lexiconIndex {
+	return &lexiconIndex{
+		byPrimaryPos:    make(map[turkish.PrimaryPos][]*DictionaryItem),
+		bySecondaryPos:  make(map[turkish.SecondaryPos][]*DictionaryItem),
+		byAttribute:     make(map[turkish.RootAttribute][]*DictionaryItem),
+		byRoot:          make(map[string][]*DictionaryItem),
+		byPronunciation: make(map[string][]*DictionaryItem),
+	}
+}
+
+func (ix *lexiconIndex) add(item *DictionaryItem) {
+	ix.items = append(ix.items, item)
+	ix.byPrimaryPos[item.PrimaryPos] = append(ix.byPrimaryPos[item.PrimaryPos], item)
+	ix.bySecondaryPos[item.SecondaryPos] = append(ix.bySecondaryPos[item.SecondaryPos], item)
+	for attr, ok := range item.Attributes {
+		if ok {
+			ix.byAttribute[attr] = append(ix.byAttribute[attr], item)
+		}
+	}
+	ix.byRoot[item.Root] = append(ix.byRoot[item.Root], item)
+	ix.byPronunciation[item.Pronunciation] = append(ix.byPronunciation[item.Pronunciation], item)
+
+	ix.sortedLemmas = nil
+	ix.reversedLemmas = nil
+}
+
+// index returns the lexicon index, building it from ItemSet if the lexicon
+// was not created with NewRootLexicon
+func (rl *RootLexicon) index() *lexiconIndex {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	if rl.idx == nil {
+		rl.idx = newLexiconIndex()
+		for _, item := range sortedByID(rl.ItemSet) {
+			rl.idx.add(item)
+		}
+	}
+	return rl.idx
+}
+
+func sortedByID(set map[*DictionaryItem]bool) []*DictionaryItem {
+	items := make([]*DictionaryItem, 0, len(set))
+	for item := range set {
+		items = append(items, item)
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].ID < items[j].ID })
+	return items
+}
+
+// All returns an iterator over all items in insertion order without copying
+// the lexicon. The lexicon must not be modified during iteration.
+func (rl *RootLexicon) All() iter.Seq[*DictionaryItem] {
+	items := rl.index().items
+	return func(yield func(*DictionaryItem) bool) {
+		for _, item := range items {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Select returns an iterator over items matching the predicate
+func (rl *RootLexicon) Select(predicate func(*DictionaryItem) bool) iter.Seq[*DictionaryItem] {
+	all := rl.All()
+	return func(yield func(*DictionaryItem) bool) {
+		for item := range all {
+			if predicate(item) && !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// GetItemsByPrimaryPos returns items with the given primary POS. The
+// returned slice is shared with the lexicon and must not be modified.
+func (rl *RootLexicon) GetItemsByPrimaryPos(pos turkish.PrimaryPos) []*DictionaryItem {
+	return rl.index().byPrimaryPos[pos]
+}
+
+// GetItemsBySecondaryPos returns items with the given secondary POS
+func (rl *RootLexicon) GetItemsBySecondaryPos(pos turkish.SecondaryPos) []*DictionaryItem {
+	return rl.index().bySecondaryPos[pos]
+}
+
+// GetItemsWithAttribute returns items having the given root attribute
+func (rl *RootLexicon) GetItemsWithAttribute(attr turkish.RootAttribute) []*DictionaryItem {
+	return rl.index().byAttribute[attr]
+}
+
+// GetItemsByRoot returns items with the given root, e.g. "gel" for "gelmek"
+func (rl *RootLexicon) GetItemsByRoot(root string) []*DictionaryItem {
+	return rl.index().byRoot[root]
+}
+
+// GetItemsByPronunciation returns items with the given pronunciation
+func (rl *RootLexicon) GetItemsByPronunciation(pronunciation string) []*DictionaryItem {
+	return rl.index().byPronunciation[pronunciation]
+}
+
+// GetItemsWithLemmaPrefix returns items whose lemma starts with prefix,
+// ordered by lemma
+func (rl *RootLexicon) GetItemsWithLemmaPrefix(prefix string) []*DictionaryItem {
+	var result []*DictionaryItem
+	for _, lemma := range searchPrefix(rl.lemmas(false), prefix) {
+		result = append(result, rl.ItemMap[lemma]...)
+	}
+	return result
+}
+
+// GetItemsWithLemmaSuffix returns items whose lemma ends with suffix,
+// ordered by reversed lemma so that words sharing longer endings are adjacent
+func (rl *RootLexicon) GetItemsWithLemmaSuffix(suffix string) []*DictionaryItem {
+	var result []*DictionaryItem
+	for _, reversed := range searchPrefix(rl.lemmas(true), reverseString(suffix)) {
+		result = append(result, rl.ItemMap[reverseString(reversed)]...)
+	}
+	return result
+}
+
+// lemmas returns the sorted lemma list, or the sorted list of reversed
+// lemmas, building it if necessary
+func (rl *RootLexicon) lemmas(reversed bool) []string {
+	ix := rl.index()
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	if ix.sortedLemmas == nil {
+		ix.sortedLemmas = make([]string, 0, len(rl.ItemMap))
+		ix.reversedLemmas = make([]string, 0, len(rl.ItemMap))
+		for lemma := range rl.ItemMap {
+			ix.sortedLemmas = append(ix.sortedLemmas, lemma)
+			ix.reversedLemmas = append(ix.reversedLemmas, reverseString(lemma))
+		}
+		sort.Strings(ix.sortedLemmas)
+		sort.Strings(ix.reversedLemmas)
+	}
+	if reversed {
+		return ix.reversedLemmas
+	}
+	return ix.sortedLemmas
+}
+
+// searchPrefix returns the range of sorted that starts with prefix
+func searchPrefix(sorted []string, prefix string) []string {
+	start := sort.SearchStrings(sorted, prefix)
+	end := start
+	for end < len(sorted) && strings.HasPrefix(sorted[end], prefix) {
+		end++
+	}
+	return sorted[start:end]
+}
+
+func reverseString(s string) string {
+	runes := []rune(s)
+	for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+		runes[i], runes[j] = runes[j], runes[i]
+	}
+	return string(runes)
+}
@@ -0,0 +1,114 @@
+package lexicon
+
+import (
+	"testing"
+
+	"github.com/kalaomer/zemberek-go/core/turkish"
+)
+
+func testLexicon() *RootLexicon {
+	attrs := func(a ...turkish.RootAttribute) map[turkish.RootAttribute]bool {
+		m := make(map[turkish.RootAttribute]bool)
+		for _, x := range a {
+			m[x] = true
+		}
+		return m
+	}
+	return NewRootLexicon([]*DictionaryItem{
+		NewDictionaryItem("kitap", "kitap", turkish.Noun, turkish.NonePos, attrs(turkish.Voicing), "", 0),
+		NewDictionaryItem("kitapçı", "kitapçı", turkish.Noun, turkish.NonePos, nil, "", 0),
+		NewDictionaryItem("ağız", "ağız", turkish.Noun, turkish.NonePos, attrs(turkish.LastVowelDrop), "", 0),
+		NewDictionaryItem("gelmek", "gel", turkish.Verb, turkish.NonePos, attrs(turkish.AoristI), "", 0),
+		NewDictionaryItem("Ankara", "ankara", turkish.Noun, turkish.ProperNoun, nil, "", 0),
+		NewDictionaryItem("TBMM", "tbmm", turkish.Noun, turkish.Abbreviation, nil, "tebeemem", 0),
+		NewDictionaryItem("hızlı", "hızlı", turkish.Adjective, turkish.NonePos, nil, "", 0),
+	})
+}
+
+func lemmasOf(items []*DictionaryItem) []string {
+	out := make([]string, len(items))
+	for i, item := range items {
+		out[i] = item.Lemma
+	}
+	return out
+}
+
+func assertLemmas(t *testing.T, name string, items []*DictionaryItem, expected ...string) {
+	t.Helper()
+	got := lemmasOf(items)
+	if len(got) != len(expected) {
+		t.Errorf("%s: expected %v, got %v", name, expected, got)
+		return
+	}
+	for i := range got {
+		if got[i] != expected[i] {
+			t.Errorf("%s: expected %v, got %v", name, expected, got)
+			return
+		}
+	}
+}
+
+func TestLexiconIndexes(t *testing.T) {
+	lex := testLexicon()
+
+	assertLemmas(t, "Verb", lex.GetItemsByPrimaryPos(turkish.Verb), "gelmek")
+	assertLemmas(t, "Adj", lex.GetItemsByPrimaryPos(turkish.Adjective), "hızlı")
+	assertLemmas(t, "Prop", lex.GetItemsBySecondaryPos(turkish.ProperNoun), "Ankara")
+	assertLemmas(t, "Voicing", lex.GetItemsWithAttribute(turkish.Voicing), "kitap")
+	assertLemmas(t, "LastVowelDrop", lex.GetItemsWithAttribute(turkish.LastVowelDrop), "ağız")
+	assertLemmas(t, "root gel", lex.GetItemsByRoot("gel"), "gelmek")
+	assertLemmas(t, "pron", lex.GetItemsByPronunciation("tebeemem"), "TBMM")
+	assertLemmas(t, "missing", lex.GetItemsByRoot("yok"))
+
+	// Items added after construction are indexed as well
+	lex.Add(NewDictionaryItem("gitmek", "git", turkish.Verb, turkish.NonePos, nil, "", 0))
+	assertLemmas(t, "Verb after add", lex.GetItemsByPrimaryPos(turkish.Verb), "gelmek", "gitmek")
+}
+
+func TestLexiconPrefixSuffixSearch(t *testing.T) {
+	lex := testLexicon()
+
+	assertLemmas(t, "prefix kitap", lex.GetItemsWithLemmaPrefix("kitap"), "kitap", "kitapçı")
+	assertLemmas(t, "prefix gel", lex.GetItemsWithLemmaPrefix("gel"), "gelmek")
+	assertLemmas(t, "prefix none", lex.GetItemsWithLemmaPrefix("zzz"))
+	assertLemmas(t, "suffix lı", lex.GetItemsWithLemmaSuffix("lı"), "hızlı")
+	assertLemmas(t, "suffix ı", lex.GetItemsWithLemmaSuffix("ı"), "hızlı", "kitapçı")
+
+	// Sorted lemma lists are rebuilt after an addition
+	lex.Add(NewDictionaryItem("kitaplık", "kitaplık", turkish.Noun, turkish.NonePos, nil, "", 0))
+	assertLemmas(t, "prefix after add", lex.GetItemsWithLemmaPrefix("kitap"), "kitap", "kitaplık", "kitapçı")
+}
+
+func TestLexiconIterator(t *testing.T) {
+	lex := testLexicon()
+
+	count := 0
+	for range lex.All() {
+		count++
+	}
+	if count != lex.Size() {
+		t.Errorf("expected %d items, got %d", lex.Size(), count)
+	}
+
+	var nouns []*DictionaryItem
+	for item := range lex.Select(func(d *DictionaryItem) bool {
+		return d.PrimaryPos == turkish.Noun && d.SecondaryPos == turkish.NonePos
+	}) {
+		nouns = append(nouns, item)
+		if len(nouns) == 2 {
+			break
+		}
+	}
+	assertLemmas(t, "Select", nouns, "kitap", "kitapçı")
+}
+
+func TestLexiconZeroValueIndex(t *testing.T) {
+	lex := &RootLexicon{
+		IDMap:   make(map[string]*DictionaryItem),
+		ItemSet: make(map[*DictionaryItem]bool),
+		ItemMap: make(map[string][]*DictionaryItem),
+	}
+	lex.Add(NewDictionaryItem("elma", "elma", turkish.Noun, turkish.NonePos, nil, "", 0))
+	assertLemmas(t, "zero value", lex.GetItemsByPrimaryPos(turkish.Noun), "elma")
+	assertLemmas(t, "zero value prefix", lex.GetItemsWithLemmaPrefix("el"), "elma")
+}
@@ -7,6 +7,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/kalaomer/zemberek-go/core/turkish"
 )
@@ -16,6 +17,9 @@ type RootLexicon struct {
 	IDMap   map[string]*DictionaryItem
 	ItemSet map[*DictionaryItem]bool
 	ItemMap map[string][]*DictionaryItem
+
+	mu  sync.Mutex
+	idx *lexiconIndex
 }
 
 // NewRootLexicon creates a new RootLexicon
@@ -24,6 +28,7 @@ func NewRootLexicon(itemList []*DictionaryItem) *RootLexicon {
 		IDMap:   make(map[string]*DictionaryItem),
 		ItemSet: make(map[*DictionaryItem]bool),
 		ItemMap: make(map[string][]*DictionaryItem),
+		idx:     newLexiconIndex(),
 	}
 
 	for _, item := range itemList {
@@ -53,6 +58,12 @@ func (rl *RootLexicon) Add(item *DictionaryItem) {
 	} else {
 		rl.ItemMap[item.Lemma] = []*DictionaryItem{item}
 	}
+
+	if rl.idx != nil {
+		rl.mu.Lock()
+		rl.idx.add(item)
+		rl.mu.Unlock()
+	}
 }
 
 // GetItemByID gets an item by ID
@@ -3,8 +3,45 @@ package morphology
 import (
 	"fmt"
 	"testing"
+
+	"github.com/kalaomer/zemberek-go/core/turkish"
+	"github.com/kalaomer/zemberek-go/morphology/analysis"
+	"github.com/kalaomer/zemberek-go/morphology/lexicon"
 )
 
+// testMorphology returns a morphology with a small lexicon, shared by the
+// tests that do not need the full dictionaries
+func testMorphology() *TurkishMorphology {
+	voicing := map[turkish.RootAttribute]bool{turkish.Voicing: true}
+	aoristI := map[turkish.RootAttribute]bool{turkish.AoristI: true}
+	lex := lexicon.NewRootLexicon([]*lexicon.DictionaryItem{
+		lexicon.NewDictionaryItem("ev", "ev", turkish.Noun, turkish.NonePos, nil, "", 0),
+		lexicon.NewDictionaryItem("göz", "göz", turkish.Noun, turkish.NonePos, nil, "", 0),
+		lexicon.NewDictionaryItem("kitap", "kitap", turkish.Noun, turkish.NonePos, voicing, "", 0),
+		lexicon.NewDictionaryItem("araba", "araba", turkish.Noun, turkish.NonePos, nil, "", 0),
+		lexicon.NewDictionaryItem("defter", "defter", turkish.Noun, turkish.NonePos, nil, "", 0),
+		lexicon.NewDictionaryItem("Ankara", "ankara", turkish.Noun, turkish.ProperNoun, nil, "", 0),
+		lexicon.NewDictionaryItem("İzmir", "izmir", turkish.Noun, turkish.ProperNoun, nil, "", 0),
+		lexicon.NewDictionaryItem("gelmek", "gel", turkish.Verb, turkish.NonePos, aoristI, "", 0),
+		lexicon.NewDictionaryItem("okumak", "oku", turkish.Verb, turkish.NonePos, nil, "", 0),
+	})
+	return NewBuilder(lex).Build()
+}
+
+// findAnalysis returns the analysis of word formatted as format
+func findAnalysis(t *testing.T, morph *TurkishMorphology, word, format string) *analysis.SingleAnalysis {
+	t.Helper()
+	var formats []string
+	for _, a := range morph.Analyze(word).AnalysisResults {
+		if a.FormatString() == format {
+			return a
+		}
+		formats = append(formats, a.FormatString())
+	}
+	t.Fatalf("%s: analysis %s not found in %v", word, format, formats)
+	return nil
+}
+
 func TestMorphologicalAnalysis_BasicWord(t *testing.T) {
 	// Create morphology instance
 	morphology := CreateWithDefaults()
@@ -1,6 +1,10 @@
 package morphotactics
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
 	"github.com/kalaomer/zemberek-go/core/turkish"
 	"github.com/kalaomer/zemberek-go/morphology/lexicon"
 )
@@ -303,3 +307,89 @@ func Has(pAttr *turkish.PhoneticAttribute, rAttr *turkish.RootAttribute) Conditi
 	}
 	return &HasRootAttribute{Attribute: *rAttr}
 }
+
+// String forms use the condition syntax of morphotactics definitions, so a
+// printed condition can be parsed back with MorphotacticsParser.ParseCondition
+
+func (c *HasPhoneticAttribute) String() string     { return "Has(" + c.Attribute.Name() + ")" }
+func (c *NotCondition) String() string             { return "Not(" + ConditionString(c.Condition) + ")" }
+func (c *HasRootAttribute) String() string         { return "Has(" + c.Attribute.GetStringForm() + ")" }
+func (c *HasTail) String() string                  { return "HasTail" }
+func (c *HasAnySuffixSurface) String() string      { return "HasAnySuffixSurface" }
+func (c *NoSurfaceAfterDerivation) String() string { return "NoSurfaceAfterDerivation" }
+
+func (c *CombinedCondition) String() string {
+	parts := make([]string, len(c.Conditions))
+	for i, cond := range c.Conditions {
+		parts[i] = ConditionString(cond)
+	}
+	name := "And"
+	if c.Operator == OR {
+		name = "Or"
+	}
+	return name + "(" + strings.Join(parts, ", ") + ")"
+}
+
+func (c *DictionaryItemIs) String() string {
+	if c.Item == nil {
+		return "DictionaryItemIs(<nil>)"
+	}
+	return "DictionaryItemIs(" + c.Item.ID + ")"
+}
+
+func (c *DictionaryItemIsAny) String() string {
+	return "DictionaryItemIsAny(" + strings.Join(itemIDs(c.Items), ", ") + ")"
+}
+
+func (c *DictionaryItemIsNone) String() string {
+	return "DictionaryItemIsNone(" + strings.Join(itemIDs(c.Items), ", ") + ")"
+}
+
+func (c *PreviousMorphemeIs) String() string {
+	if c.Morpheme == nil {
+		return "PreviousMorphemeIs(<nil>)"
+	}
+	return "PreviousMorphemeIs(" + c.Morpheme.ID + ")"
+}
+
+func itemIDs(items map[*lexicon.DictionaryItem]bool) []string {
+	ids := make([]string, 0, len(items))
+	for item, ok := range items {
+		if ok {
+			ids = append(ids, item.ID)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// ConditionString returns the printable form of a condition. Conditions
+// defined outside this package are printed by type if they do not implement
+// fmt.Stringer. A nil condition prints as an empty string.
+func ConditionString(c Condition) string {
+	if c == nil {
+		return ""
+	}
+	if s, ok := c.(fmt.Stringer); ok {
+		return s.String()
+	}
+	return fmt.Sprintf("%T", c)
+}
+
+// RejectingCondition returns the part of c that rejects path, or nil if c
+// accepts it. For an And combination this is the first rejecting operand, so
+// a template condition joined with an explicit one reports only the failing
+// side. Or combinations and negations are returned as a whole.
+func RejectingCondition(c Condition, path SearchPathInterface) Condition {
+	if c == nil || c.Accept(path) {
+		return nil
+	}
+	if cc, ok := c.(*CombinedCondition); ok && (cc.Operator == AND || len(cc.Conditions) == 1) {
+		for _, operand := range cc.Conditions {
+			if rejecting := RejectingCondition(operand, path); rejecting != nil {
+				return rejecting
+			}
+		}
+	}
+	return c
+}
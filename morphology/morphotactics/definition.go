@@ -0,0 +1,641 @@
+package morphotactics
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"strings"
+
+	"github.com/kalaomer/zemberek-go/core/turkish"
+	"github.com/kalaomer/zemberek-go/morphology/lexicon"
+)
+
+// DefaultDefinition is the textual definition of the built-in morphotactics
+// graph. It describes the same graph as NewTurkishMorphotactics and is meant
+// as a starting point for experiments and extensions.
+//
+//go:embed data/turkish-morphotactics.txt
+var DefaultDefinition string
+
+// MorphotacticsParser reads one or more morphotactics definitions and builds a
+// TurkishMorphotactics from them. Parsing several definitions lets an
+// extension file add morphemes, states and transitions to a base definition.
+//
+// A definition is a line based text file. Empty lines and lines starting with
+// # are ignored. Four kinds of lines are recognized:
+//
+//	morpheme <id> <name> [derivational] [informal] [pos=<Pos>]
+//	state <id> <morphemeId> [terminal] [derivative] [posRoot]
+//	<from>[, <from>...] -> <to> [<template>] [if <condition>]
+//	root <Pos|default> <stateId>
+//
+// Morphemes already known to the package (see GetMorphemeMap) may be used
+// without a morpheme line. Templates use the suffix template syntax of
+// NewSuffixTransition (>dAn, +yI, lI~k, ...); a transition without a template
+// is an empty transition. Conditions are written as
+//
+//	Has(LastLetterVowel)          phonetic or root attribute
+//	NotHave(Voicing)
+//	HasTail, HasAnySuffixSurface, HasNoSurface, NoSurfaceAfterDerivation
+//	PreviousMorphemeIs(Neg)
+//	DictionaryItemIs(değil_Verb)
+//	DictionaryItemIsAny(ben_Pron_Pers, sen_Pron_Pers)
+//	DictionaryItemIsNone(...)
+//	Not(c), And(c1, c2, ...), Or(c1, c2, ...)
+//
+// and can be combined with the infix operators !, & and |, & binding tighter
+// than |. Dictionary items that are not in the lexicon are ignored, so a
+// DictionaryItemIs condition for a missing item never matches.
+type MorphotacticsParser struct {
+	lexicon     *lexicon.RootLexicon
+	morphemes   map[string]*Morpheme
+	states      map[string]*MorphemeState
+	stateOrder  []string
+	transitions []transitionDef
+	rootStates  map[turkish.PrimaryPos]string
+	defaultRoot string
+}
+
+type transitionDef struct {
+	source    string
+	line      int
+	from      []string
+	to        string
+	template  string
+	condition Condition
+}
+
+// NewMorphotacticsParser creates a parser. lex is used for dictionary item
+// conditions and for the stem transitions of the resulting morphotactics.
+func NewMorphotacticsParser(lex *lexicon.RootLexicon) *MorphotacticsParser {
+	p := &MorphotacticsParser{
+		lexicon:    lex,
+		morphemes:  make(map[string]*Morpheme),
+		states:     make(map[string]*MorphemeState),
+		rootStates: make(map[turkish.PrimaryPos]string),
+	}
+	for id, m := range morphemeMap {
+		p.morphemes[id] = m
+	}
+	return p
+}
+
+// ParseMorphotactics builds a TurkishMorphotactics from a single definition
+func ParseMorphotactics(r io.Reader, lex *lexicon.RootLexicon) (*TurkishMorphotactics, error) {
+	p := NewMorphotacticsParser(lex)
+	if err := p.Parse(r, "definition"); err != nil {
+		return nil, err
+	}
+	return p.Build()
+}
+
+// LoadMorphotactics builds a TurkishMorphotactics from definition files.
+// Later files extend earlier ones.
+func LoadMorphotactics(lex *lexicon.RootLexicon, paths ...string) (*TurkishMorphotactics, error) {
+	p := NewMorphotacticsParser(lex)
+	for _, path := range paths {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		err = p.Parse(f, path)
+		f.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return p.Build()
+}
+
+// LoadMorphotacticsFS is like LoadMorphotactics but reads the definitions
+// from fsys
+func LoadMorphotacticsFS(fsys fs.FS, lex *lexicon.RootLexicon, names ...string) (*TurkishMorphotactics, error) {
+	p := NewMorphotacticsParser(lex)
+	for _, name := range names {
+		f, err := fsys.Open(name)
+		if err != nil {
+			return nil, err
+		}
+		err = p.Parse(f, name)
+		f.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return p.Build()
+}
+
+// Parse reads a definition. source is used in error messages.
+func (p *MorphotacticsParser) Parse(r io.Reader, source string) error {
+	scanner := bufio.NewScanner(r)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if err := p.parseLine(line, source, lineNumber); err != nil {
+			return fmt.Errorf("morphotactics: %s:%d: %w", source, lineNumber, err)
+		}
+	}
+	return scanner.Err()
+}
+
+func (p *MorphotacticsParser) parseLine(line, source string, lineNumber int) error {
+	if strings.Contains(line, "->") {
+		return p.parseTransition(line, source, lineNumber)
+	}
+
+	fields := strings.Fields(line)
+	switch fields[0] {
+	case "morpheme":
+		return p.parseMorpheme(fields[1:])
+	case "state":
+		return p.parseState(fields[1:])
+	case "root":
+		return p.parseRoot(fields[1:])
+	default:
+		return fmt.Errorf("unknown directive %q", fields[0])
+	}
+}
+
+func (p *MorphotacticsParser) parseMorpheme(fields []string) error {
+	if len(fields) < 2 {
+		return fmt.Errorf("morpheme needs an id and a name")
+	}
+	builder := NewMorphemeBuilder(fields[1], fields[0])
+	for _, flag := range fields[2:] {
+		switch {
+		case flag == "derivational":
+			builder.Derivational()
+		case flag == "informal":
+			builder.Informal()
+		case strings.HasPrefix(flag, "pos="):
+			pos, ok := turkish.PrimaryPosFromString(strings.TrimPrefix(flag, "pos="))
+			if !ok {
+				return fmt.Errorf("unknown POS %q", flag)
+			}
+			builder.WithPos(pos)
+		default:
+			return fmt.Errorf("unknown morpheme flag %q", flag)
+		}
+	}
+
+	// Known morphemes are shared so that analyses keep referring to the
+	// package level values
+	if _, exists := p.morphemes[fields[0]]; !exists {
+		p.morphemes[fields[0]] = builder.Build()
+	}
+	return nil
+}
+
+func (p *MorphotacticsParser) parseState(fields []string) error {
+	if len(fields) < 2 {
+		return fmt.Errorf("state needs an id and a morpheme")
+	}
+	id := fields[0]
+	if _, exists := p.states[id]; exists {
+		return fmt.Errorf("duplicate state %q", id)
+	}
+	morpheme, ok := p.morphemes[fields[1]]
+	if !ok {
+		return fmt.Errorf("unknown morpheme %q", fields[1])
+	}
+
+	builder := NewMorphemeStateBuilder(id, morpheme)
+	for _, flag := range fields[2:] {
+		switch flag {
+		case "terminal":
+			builder.SetTerminal(true)
+		case "derivative":
+			builder.SetDerivative(true)
+		case "posRoot":
+			builder.SetPosRoot(true)
+		default:
+			return fmt.Errorf("unknown state flag %q", flag)
+		}
+	}
+	p.states[id] = builder.Build()
+	p.stateOrder = append(p.stateOrder, id)
+	return nil
+}
+
+func (p *MorphotacticsParser) parseRoot(fields []string) error {
+	if len(fields) != 2 {
+		return fmt.Errorf("root needs a POS and a state")
+	}
+	if fields[0] == "default" {
+		p.defaultRoot = fields[1]
+		return nil
+	}
+	pos, ok := turkish.PrimaryPosFromString(fields[0])
+	if !ok {
+		return fmt.Errorf("unknown POS %q", fields[0])
+	}
+	p.rootStates[pos] = fields[1]
+	return nil
+}
+
+func (p *MorphotacticsParser) parseTransition(line, source string, lineNumber int) error {
+	arrow := strings.Index(line, "->")
+	def := transitionDef{source: source, line: lineNumber}
+
+	for _, from := range strings.Split(line[:arrow], ",") {
+		from = strings.TrimSpace(from)
+		if from == "" {
+			return fmt.Errorf("empty source state")
+		}
+		def.from = append(def.from, from)
+	}
+
+	rest := strings.TrimSpace(line[arrow+2:])
+	var conditionText string
+	if i := indexOfKeyword(rest, "if"); i >= 0 {
+		conditionText = strings.TrimSpace(rest[i+2:])
+		rest = strings.TrimSpace(rest[:i])
+	}
+
+	fields := strings.Fields(rest)
+	switch len(fields) {
+	case 1:
+		def.to = fields[0]
+	case 2:
+		def.to = fields[0]
+		def.template = fields[1]
+	default:
+		return fmt.Errorf("transition needs a target state and at most one template")
+	}
+
+	if conditionText != "" {
+		condition, err := p.ParseCondition(conditionText)
+		if err != nil {
+			return err
+		}
+		def.condition = condition
+	}
+
+	p.transitions = append(p.transitions, def)
+	return nil
+}
+
+// indexOfKeyword finds keyword as a separate word in s
+func indexOfKeyword(s, keyword string) int {
+	fields := strings.Fields(s)
+	offset := 0
+	for _, field := range fields {
+		i := strings.Index(s[offset:], field) + offset
+		if field == keyword {
+			return i
+		}
+		offset = i + len(field)
+	}
+	return -1
+}
+
+// Build creates the morphotactics from everything parsed so far. Known state
+// IDs (noun_S, verbRoot_S, ...) are also bound to the exported state fields.
+// States are shared with the result, so Build must be called only once.
+func (p *MorphotacticsParser) Build() (*TurkishMorphotactics, error) {
+	tm := &TurkishMorphotactics{
+		lexicon:    p.lexicon,
+		states:     make(map[string]*MorphemeState),
+		rootStates: make(map[turkish.PrimaryPos]*MorphemeState),
+	}
+
+	for _, id := range p.stateOrder {
+		tm.states[id] = p.states[id]
+	}
+	if _, ok := tm.states["root_S"]; !ok {
+		tm.states["root_S"] = NewMorphemeStateNonTerminal("root_S", Root)
+	}
+	for id, field := range tm.namedStates() {
+		*field = tm.states[id]
+	}
+
+	for _, def := range p.transitions {
+		to, ok := tm.states[def.to]
+		if !ok {
+			return nil, fmt.Errorf("morphotactics: %s:%d: unknown state %q", def.source, def.line, def.to)
+		}
+		for _, fromID := range def.from {
+			from, ok := tm.states[fromID]
+			if !ok {
+				return nil, fmt.Errorf("morphotactics: %s:%d: unknown state %q", def.source, def.line, fromID)
+			}
+			NewSuffixTransitionBuilder(from, to).SetTemplate(def.template).SetCondition(def.condition).Build()
+		}
+	}
+
+	for pos, id := range p.rootStates {
+		state, ok := tm.states[id]
+		if !ok {
+			return nil, fmt.Errorf("morphotactics: unknown root state %q for %s", id, pos.GetStringForm())
+		}
+		tm.rootStates[pos] = state
+	}
+	if p.defaultRoot != "" {
+		state, ok := tm.states[p.defaultRoot]
+		if !ok {
+			return nil, fmt.Errorf("morphotactics: unknown default root state %q", p.defaultRoot)
+		}
+		tm.defaultRoot = state
+	} else {
+		tm.defaultRoot = tm.rootStates[turkish.Noun]
+	}
+	if tm.defaultRoot == nil {
+		return nil, fmt.Errorf("morphotactics: no default root state defined")
+	}
+
+	tm.stemTransitions = NewStemTransitionsMapBased(p.lexicon, tm)
+	return tm, nil
+}
+
+// ParseCondition parses a condition expression using the parser's morphemes
+// and lexicon
+func (p *MorphotacticsParser) ParseCondition(s string) (Condition, error) {
+	cp := &conditionParser{p: p, tokens: tokenizeCondition(s)}
+	c, err := cp.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if cp.pos < len(cp.tokens) {
+		return nil, fmt.Errorf("unexpected %q in condition %q", cp.tokens[cp.pos], s)
+	}
+	return c, nil
+}
+
+func tokenizeCondition(s string) []string {
+	var tokens []string
+	var current strings.Builder
+	flush := func() {
+		if current.Len() > 0 {
+			tokens = append(tokens, current.String())
+			current.Reset()
+		}
+	}
+	for _, r := range s {
+		switch r {
+		case '(', ')', ',', '&', '|', '!':
+			flush()
+			tokens = append(tokens, string(r))
+		case ' ', '\t':
+			flush()
+		default:
+			current.WriteRune(r)
+		}
+	}
+	flush()
+	return tokens
+}
+
+type conditionParser struct {
+	p      *MorphotacticsParser
+	tokens []string
+	pos    int
+}
+
+func (cp *conditionParser) peek() string {
+	if cp.pos < len(cp.tokens) {
+		return cp.tokens[cp.pos]
+	}
+	return ""
+}
+
+func (cp *conditionParser) expect(token string) error {
+	if cp.peek() != token {
+		if cp.peek() == "" {
+			return fmt.Errorf("expected %q at end of condition", token)
+		}
+		return fmt.Errorf("expected %q, found %q", token, cp.peek())
+	}
+	cp.pos++
+	return nil
+}
+
+func (cp *conditionParser) parseOr() (Condition, error) {
+	left, err := cp.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for cp.peek() == "|" {
+		cp.pos++
+		right, err := cp.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = CondOr(left, right)
+	}
+	return left, nil
+}
+
+func (cp *conditionParser) parseAnd() (Condition, error) {
+	left, err := cp.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for cp.peek() == "&" {
+		cp.pos++
+		right, err := cp.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = CondAnd(left, right)
+	}
+	return left, nil
+}
+
+func (cp *conditionParser) parseUnary() (Condition, error) {
+	switch cp.peek() {
+	case "!":
+		cp.pos++
+		c, err := cp.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return CondNot(c), nil
+	case "(":
+		cp.pos++
+		c, err := cp.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		return c, cp.expect(")")
+	case "", ")", ",", "&", "|":
+		return nil, fmt.Errorf("condition expected")
+	}
+	name := cp.tokens[cp.pos]
+	cp.pos++
+	return cp.parseCall(name)
+}
+
+// parseNameArgs reads a parenthesized list of names such as attributes,
+// morpheme IDs or dictionary item IDs
+func (cp *conditionParser) parseNameArgs() ([]string, error) {
+	if err := cp.expect("("); err != nil {
+		return nil, err
+	}
+	var args []string
+	for {
+		token := cp.peek()
+		if token == "" || token == "(" || token == "," || token == ")" {
+			return nil, fmt.Errorf("argument expected")
+		}
+		args = append(args, token)
+		cp.pos++
+		if cp.peek() == ")" {
+			cp.pos++
+			return args, nil
+		}
+		if err := cp.expect(","); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (cp *conditionParser) parseConditionArgs() ([]Condition, error) {
+	if err := cp.expect("("); err != nil {
+		return nil, err
+	}
+	var args []Condition
+	for {
+		c, err := cp.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, c)
+		if cp.peek() == ")" {
+			cp.pos++
+			return args, nil
+		}
+		if err := cp.expect(","); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (cp *conditionParser) parseCall(name string) (Condition, error) {
+	switch name {
+	case "HasTail":
+		return HAS_TAIL, nil
+	case "HasAnySuffixSurface", "HasSurface":
+		return HAS_SURFACE, nil
+	case "HasNoSurface":
+		return HAS_NO_SURFACE, nil
+	case "NoSurfaceAfterDerivation", "CurrentGroupEmpty":
+		return CURRENT_GROUP_EMPTY, nil
+
+	case "Has", "NotHave":
+		args, err := cp.parseNameArgs()
+		if err != nil {
+			return nil, err
+		}
+		if len(args) != 1 {
+			return nil, fmt.Errorf("%s takes one attribute", name)
+		}
+		c, err := attributeCondition(args[0])
+		if err != nil {
+			return nil, err
+		}
+		if name == "NotHave" {
+			return c.Not(), nil
+		}
+		return c, nil
+
+	case "PreviousMorphemeIs":
+		args, err := cp.parseNameArgs()
+		if err != nil {
+			return nil, err
+		}
+		if len(args) != 1 {
+			return nil, fmt.Errorf("PreviousMorphemeIs takes one morpheme")
+		}
+		m, ok := cp.p.morphemes[args[0]]
+		if !ok {
+			return nil, fmt.Errorf("unknown morpheme %q", args[0])
+		}
+		return &PreviousMorphemeIs{Morpheme: m}, nil
+
+	case "DictionaryItemIs":
+		args, err := cp.parseNameArgs()
+		if err != nil {
+			return nil, err
+		}
+		if len(args) != 1 {
+			return nil, fmt.Errorf("DictionaryItemIs takes one item id")
+		}
+		return &DictionaryItemIs{Item: cp.p.lookupItem(args[0])}, nil
+
+	case "DictionaryItemIsAny", "DictionaryItemIsNone":
+		args, err := cp.parseNameArgs()
+		if err != nil {
+			return nil, err
+		}
+		items := make(map[*lexicon.DictionaryItem]bool)
+		for _, id := range args {
+			if item := cp.p.lookupItem(id); item != nil {
+				items[item] = true
+			}
+		}
+		if name == "DictionaryItemIsAny" {
+			return &DictionaryItemIsAny{Items: items}, nil
+		}
+		return &DictionaryItemIsNone{Items: items}, nil
+
+	case "Not":
+		args, err := cp.parseConditionArgs()
+		if err != nil {
+			return nil, err
+		}
+		if len(args) != 1 {
+			return nil, fmt.Errorf("Not takes one condition")
+		}
+		return CondNot(args[0]), nil
+
+	case "And", "Or":
+		args, err := cp.parseConditionArgs()
+		if err != nil {
+			return nil, err
+		}
+		c := args[0]
+		for _, arg := range args[1:] {
+			if name == "And" {
+				c = CondAnd(c, arg)
+			} else {
+				c = CondOr(c, arg)
+			}
+		}
+		return c, nil
+	}
+	return nil, fmt.Errorf("unknown condition %q", name)
+}
+
+func attributeCondition(name string) (Condition, error) {
+	if attr, ok := turkish.PhoneticAttributeFromString(name); ok {
+		return Has(&attr, nil), nil
+	}
+	if attr, ok := turkish.RootAttributeFromString(name); ok {
+		return Has(nil, &attr), nil
+	}
+	return nil, fmt.Errorf("unknown attribute %q", name)
+}
+
+func (p *MorphotacticsParser) lookupItem(id string) *lexicon.DictionaryItem {
+	if p.lexicon == nil {
+		return nil
+	}
+	return p.lexicon.GetItemByID(id)
+}
+
+// NewTurkishMorphotacticsFromDefinition builds the morphotactics described by
+// DefaultDefinition
+func NewTurkishMorphotacticsFromDefinition(lex *lexicon.RootLexicon) (*TurkishMorphotactics, error) {
+	p := NewMorphotacticsParser(lex)
+	if err := p.Parse(strings.NewReader(DefaultDefinition), "turkish-morphotactics.txt"); err != nil {
+		return nil, err
+	}
+	return p.Build()
+}
@@ -0,0 +1,255 @@
+package morphotactics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kalaomer/zemberek-go/core/turkish"
+	"github.com/kalaomer/zemberek-go/morphology/lexicon"
+)
+
+func definitionTestLexicon() *lexicon.RootLexicon {
+	return lexicon.NewRootLexicon([]*lexicon.DictionaryItem{
+		lexicon.NewDictionaryItem("ev", "ev", turkish.Noun, turkish.NonePos, nil, "", 0),
+		lexicon.NewDictionaryItem("gelmek", "gel", turkish.Verb, turkish.NonePos, nil, "", 0),
+		lexicon.NewDictionaryItem("güzel", "güzel", turkish.Adjective, turkish.NonePos, nil, "", 0),
+	})
+}
+
+// mockPath is a minimal SearchPathInterface for evaluating conditions
+type mockPath struct {
+	item     *lexicon.DictionaryItem
+	previous *MorphemeState
+	attrs    map[turkish.PhoneticAttribute]bool
+	surface  bool
+	tail     string
+}
+
+func (m *mockPath) GetDictionaryItem() *lexicon.DictionaryItem { return m.item }
+func (m *mockPath) HasDictionaryItem(item *lexicon.DictionaryItem) bool {
+	return m.item == item
+}
+func (m *mockPath) GetPreviousState() *MorphemeState      { return m.previous }
+func (m *mockPath) GetStemTransition() *StemTransition    { return nil }
+func (m *mockPath) GetContainsSuffixWithSurface() bool    { return m.surface }
+func (m *mockPath) GetTail() string                       { return m.tail }
+func (m *mockPath) GetTransitions() []TransitionInterface { return nil }
+func (m *mockPath) GetPhoneticAttributes() map[turkish.PhoneticAttribute]bool {
+	return m.attrs
+}
+
+func TestDefaultDefinitionMatchesBuiltIn(t *testing.T) {
+	lex := definitionTestLexicon()
+	builtIn := NewTurkishMorphotactics(lex)
+	parsed, err := NewTurkishMorphotacticsFromDefinition(lex)
+	if err != nil {
+		t.Fatalf("NewTurkishMorphotacticsFromDefinition: %v", err)
+	}
+
+	for id, field := range builtIn.namedStates() {
+		expected := *field
+		if expected.ID != id {
+			t.Errorf("state field for %s has ID %s", id, expected.ID)
+		}
+		actual := parsed.GetState(id)
+		if actual == nil {
+			t.Errorf("state %s missing from definition", id)
+			continue
+		}
+		if actual.Morpheme.ID != expected.Morpheme.ID || actual.Terminal != expected.Terminal ||
+			actual.Derivative != expected.Derivative || actual.PosRoot != expected.PosRoot {
+			t.Errorf("state %s differs: %+v vs %+v", id, actual, expected)
+		}
+		if len(actual.Outgoing) != len(expected.Outgoing) {
+			t.Errorf("state %s has %d outgoing transitions, expected %d", id, len(actual.Outgoing), len(expected.Outgoing))
+			continue
+		}
+		for i := range expected.Outgoing {
+			e := expected.Outgoing[i].(*SuffixTransition)
+			a := actual.Outgoing[i].(*SuffixTransition)
+			if a.To.ID != e.To.ID || a.SurfaceTemplate != e.SurfaceTemplate || a.ConditionCount != e.ConditionCount {
+				t.Errorf("transition %d of %s: got %s (%d conditions), expected %s (%d conditions)",
+					i, id, a, a.ConditionCount, e, e.ConditionCount)
+			}
+		}
+	}
+
+	for _, item := range lex.GetAllItems() {
+		e := builtIn.GetRootState(item, nil)
+		a := parsed.GetRootState(item, nil)
+		if a.ID != e.ID {
+			t.Errorf("root state of %s: got %s, expected %s", item.ID, a.ID, e.ID)
+		}
+	}
+
+	if parsed.NounS != parsed.GetState("noun_S") || parsed.VerbRoot == nil {
+		t.Error("named state fields are not bound")
+	}
+	if len(parsed.GetStemTransitions().GetTransitions("gel")) == 0 {
+		t.Error("expected stem transitions for gel")
+	}
+}
+
+func TestParseCondition(t *testing.T) {
+	lex := definitionTestLexicon()
+	ev := lex.GetItemByID("ev_Noun")
+	gel := lex.GetItemByID("gelmek_Verb")
+	p := NewMorphotacticsParser(lex)
+
+	negState := NewMorphemeStateNonTerminal("vNeg_S", Neg)
+	vowelPath := &mockPath{item: ev, attrs: map[turkish.PhoneticAttribute]bool{turkish.LastLetterVowel: true}}
+	consonantPath := &mockPath{item: gel, previous: negState, surface: true, tail: "di",
+		attrs: map[turkish.PhoneticAttribute]bool{turkish.LastLetterConsonant: true}}
+
+	tests := []struct {
+		condition string
+		vowel     bool
+		consonant bool
+	}{
+		{"Has(LastLetterVowel)", true, false},
+		{"Has(LLV)", true, false},
+		{"NotHave(LastLetterVowel)", false, true},
+		{"HasTail", false, true},
+		{"HasNoSurface", true, false},
+		{"HasAnySuffixSurface", false, true},
+		{"PreviousMorphemeIs(Neg)", false, true},
+		{"DictionaryItemIs(ev_Noun)", true, false},
+		{"DictionaryItemIs(missing_Noun)", false, false},
+		{"DictionaryItemIsAny(ev_Noun, gelmek_Verb)", true, true},
+		{"DictionaryItemIsNone(ev_Noun, missing_Noun)", false, true},
+		{"Not(HasTail)", true, false},
+		{"And(HasTail, Has(LastLetterConsonant))", false, true},
+		{"Or(HasTail, Has(LastLetterVowel))", true, true},
+		{"!HasTail & Has(LastLetterVowel)", true, false},
+		{"HasTail | DictionaryItemIs(ev_Noun) & HasNoSurface", true, true},
+		{"(HasTail | DictionaryItemIs(ev_Noun)) & HasAnySuffixSurface", false, true},
+		{"Has(Voicing) | Has(Aorist_I) | Has(AoristI)", false, false},
+	}
+
+	for _, tt := range tests {
+		c, err := p.ParseCondition(tt.condition)
+		if err != nil {
+			t.Errorf("ParseCondition(%q): %v", tt.condition, err)
+			continue
+		}
+		if got := c.Accept(vowelPath); got != tt.vowel {
+			t.Errorf("%q on vowel path: got %v, expected %v", tt.condition, got, tt.vowel)
+		}
+		if got := c.Accept(consonantPath); got != tt.consonant {
+			t.Errorf("%q on consonant path: got %v, expected %v", tt.condition, got, tt.consonant)
+		}
+	}
+}
+
+func TestParseConditionErrors(t *testing.T) {
+	p := NewMorphotacticsParser(nil)
+	for _, condition := range []string{
+		"Has(NoSuchAttribute)",
+		"PreviousMorphemeIs(NoSuchMorpheme)",
+		"Has(LastLetterVowel",
+		"HasTail &",
+		"HasTail HasTail",
+		"Unknown",
+		"Not(HasTail, HasTail)",
+	} {
+		if _, err := p.ParseCondition(condition); err == nil {
+			t.Errorf("expected error for %q", condition)
+		}
+	}
+}
+
+func TestDefinitionExtension(t *testing.T) {
+	lex := definitionTestLexicon()
+	extension := `
+# Informal progressive: geliyom
+morpheme Prog1Informal InformalProgressive informal
+state vProg1Informal_S Prog1Informal
+verbRoot_S -> vProg1Informal_S Iyo if NotHave(LastLetterVowel)
+vProg1Informal_S -> vA1sg_ST m
+`
+	p := NewMorphotacticsParser(lex)
+	if err := p.Parse(strings.NewReader(DefaultDefinition), "base"); err != nil {
+		t.Fatalf("Parse base: %v", err)
+	}
+	if err := p.Parse(strings.NewReader(extension), "extension"); err != nil {
+		t.Fatalf("Parse extension: %v", err)
+	}
+	tm, err := p.Build()
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+
+	state := tm.GetState("vProg1Informal_S")
+	if state == nil {
+		t.Fatal("extension state missing")
+	}
+	if !state.Morpheme.Informal {
+		t.Error("expected informal morpheme")
+	}
+	var found *SuffixTransition
+	for _, tr := range tm.VerbRoot.Outgoing {
+		if st := tr.(*SuffixTransition); st.To == state {
+			found = st
+		}
+	}
+	if found == nil || found.SurfaceTemplate != "Iyo" || found.Condition == nil {
+		t.Fatalf("extension transition not added: %v", found)
+	}
+}
+
+func TestDefinitionErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		definition string
+		message    string
+	}{
+		{"unknown directive", "foo bar", "unknown directive"},
+		{"unknown morpheme", "state x_S NoSuchMorpheme", "unknown morpheme"},
+		{"duplicate state", "state x_S Noun\nstate x_S Noun", "duplicate state"},
+		{"unknown flag", "state x_S Noun final", "unknown state flag"},
+		{"unknown target", "state x_S Noun\nroot Noun x_S\nx_S -> y_S", "unknown state"},
+		{"bad condition", "state x_S Noun\nx_S -> x_S lAr if Has(", "definition:2"},
+		{"no root", "state x_S Noun", "no default root"},
+		{"unknown pos", "state x_S Noun\nroot Nown x_S", "unknown POS"},
+	}
+	for _, tt := range tests {
+		_, err := ParseMorphotactics(strings.NewReader(tt.definition), nil)
+		if err == nil {
+			t.Errorf("%s: expected error", tt.name)
+			continue
+		}
+		if !strings.Contains(err.Error(), tt.message) {
+			t.Errorf("%s: expected error containing %q, got %v", tt.name, tt.message, err)
+		}
+	}
+}
+
+func TestRejectingCondition(t *testing.T) {
+	lex := definitionTestLexicon()
+	p := NewMorphotacticsParser(lex)
+	path := &mockPath{item: lex.GetItemByID("ev_Noun"), tail: "de",
+		attrs: map[turkish.PhoneticAttribute]bool{turkish.LastLetterConsonant: true}}
+
+	tests := []struct {
+		condition string
+		rejecting string
+	}{
+		{"HasTail", ""},
+		{"Has(LastLetterVowel)", "Has(LastLetterVowel)"},
+		{"And(HasTail, Has(LastLetterVowel), HasAnySuffixSurface)", "Has(LastLetterVowel)"},
+		{"And(HasTail, Or(Has(LastLetterVowel), HasAnySuffixSurface))", "Or(Has(LastLetterVowel), HasAnySuffixSurface)"},
+		{"Not(And(HasTail, DictionaryItemIs(ev_Noun)))", "Not(And(HasTail, DictionaryItemIs(ev_Noun)))"},
+	}
+	for _, tt := range tests {
+		c, err := p.ParseCondition(tt.condition)
+		if err != nil {
+			t.Fatalf("ParseCondition(%q): %v", tt.condition, err)
+		}
+		if got := ConditionString(RejectingCondition(c, path)); got != tt.rejecting {
+			t.Errorf("%s: expected %q, got %q", tt.condition, tt.rejecting, got)
+		}
+	}
+	if RejectingCondition(nil, path) != nil {
+		t.Error("nil condition should not reject")
+	}
+}
@@ -0,0 +1,220 @@
+package morphotactics
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/kalaomer/zemberek-go/core/turkish"
+)
+
+// Graph is a serializable snapshot of a morphotactics graph, used to export
+// it as Graphviz DOT or JSON
+type Graph struct {
+	States      []GraphState      `json:"states"`
+	Transitions []GraphTransition `json:"transitions"`
+	// PosRoots maps primary POS short forms to their root state IDs
+	PosRoots map[string]string `json:"posRoots"`
+}
+
+// GraphState is a state of an exported graph
+type GraphState struct {
+	ID          string `json:"id"`
+	Morpheme    string `json:"morpheme"`
+	Terminal    bool   `json:"terminal,omitempty"`
+	Derivative  bool   `json:"derivative,omitempty"`
+	PosRoot     bool   `json:"posRoot,omitempty"`
+	Highlighted bool   `json:"highlighted,omitempty"`
+}
+
+// GraphTransition is a suffix transition of an exported graph. Condition
+// contains template derived conditions as well as explicit ones.
+type GraphTransition struct {
+	From        string `json:"from"`
+	To          string `json:"to"`
+	Template    string `json:"template,omitempty"`
+	Condition   string `json:"condition,omitempty"`
+	Highlighted bool   `json:"highlighted,omitempty"`
+}
+
+// lexicalTransitioner is implemented by path transitions that know which
+// graph transition they were created from
+type lexicalTransitioner interface {
+	GetLexicalTransition() MorphemeTransition
+}
+
+// ExportGraph walks the outgoing transitions of RootS and the POS root states
+// and returns the reachable part of the graph. If path is not nil, the states
+// and transitions it passes through are marked as highlighted.
+func (tm *TurkishMorphotactics) ExportGraph(path SearchPathInterface) *Graph {
+	highlightedStates, highlightedTransitions := pathHighlights(path)
+
+	g := &Graph{PosRoots: make(map[string]string)}
+	var queue []*MorphemeState
+	visited := make(map[*MorphemeState]bool)
+	enqueue := func(s *MorphemeState) {
+		if s != nil && !visited[s] {
+			visited[s] = true
+			queue = append(queue, s)
+		}
+	}
+
+	enqueue(tm.RootS)
+	for _, pos := range tm.sortedRootPos() {
+		state := tm.rootStates[pos]
+		g.PosRoots[pos.GetStringForm()] = state.ID
+		enqueue(state)
+	}
+
+	for len(queue) > 0 {
+		state := queue[0]
+		queue = queue[1:]
+		g.States = append(g.States, GraphState{
+			ID:          state.ID,
+			Morpheme:    state.Morpheme.ID,
+			Terminal:    state.Terminal,
+			Derivative:  state.Derivative,
+			PosRoot:     state.PosRoot,
+			Highlighted: highlightedStates[state],
+		})
+
+		for _, t := range state.Outgoing {
+			st, ok := t.(*SuffixTransition)
+			if !ok {
+				continue
+			}
+			g.Transitions = append(g.Transitions, GraphTransition{
+				From:        st.From.ID,
+				To:          st.To.ID,
+				Template:    st.SurfaceTemplate,
+				Condition:   ConditionString(st.Condition),
+				Highlighted: highlightedTransitions[st],
+			})
+			enqueue(st.To)
+		}
+	}
+
+	return g
+}
+
+func (tm *TurkishMorphotactics) sortedRootPos() []turkish.PrimaryPos {
+	poses := make([]turkish.PrimaryPos, 0, len(tm.rootStates))
+	for pos := range tm.rootStates {
+		poses = append(poses, pos)
+	}
+	sort.Slice(poses, func(i, j int) bool { return poses[i] < poses[j] })
+	return poses
+}
+
+// pathHighlights collects the states and suffix transitions of a search path
+func pathHighlights(path SearchPathInterface) (map[*MorphemeState]bool, map[*SuffixTransition]bool) {
+	states := make(map[*MorphemeState]bool)
+	transitions := make(map[*SuffixTransition]bool)
+	if path == nil {
+		return states, transitions
+	}
+
+	var previous *MorphemeState
+	for _, t := range path.GetTransitions() {
+		state := t.GetState()
+		if state == nil {
+			continue
+		}
+		states[state] = true
+
+		if lt, ok := t.(lexicalTransitioner); ok {
+			if st, ok := lt.GetLexicalTransition().(*SuffixTransition); ok {
+				transitions[st] = true
+			}
+		} else if previous != nil {
+			// Without the graph transition every edge between the two
+			// states is marked
+			for _, out := range previous.Outgoing {
+				if st, ok := out.(*SuffixTransition); ok && st.To == state {
+					transitions[st] = true
+				}
+			}
+		}
+		previous = state
+	}
+	return states, transitions
+}
+
+// WriteJSON writes the graph as indented JSON
+func (g *Graph) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(g)
+}
+
+// WriteDOT writes the graph in Graphviz DOT format. Terminal states are drawn
+// as double circles, POS roots as boxes and derivative states dashed.
+// Highlighted states and transitions are drawn in red.
+func (g *Graph) WriteDOT(w io.Writer) error {
+	var b strings.Builder
+	b.WriteString("digraph morphotactics {\n")
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=ellipse, fontname=\"Helvetica\"];\n")
+	b.WriteString("  edge [fontname=\"Helvetica\", fontsize=10];\n")
+
+	for _, s := range g.States {
+		var attrs []string
+		attrs = append(attrs, "label="+dotQuote(s.ID+"\n"+s.Morpheme))
+		switch {
+		case s.PosRoot:
+			attrs = append(attrs, "shape=box")
+		case s.Terminal:
+			attrs = append(attrs, "shape=doublecircle")
+		}
+		if s.PosRoot && s.Terminal {
+			attrs = append(attrs, "peripheries=2")
+		}
+		if s.Derivative {
+			attrs = append(attrs, "style=dashed")
+		}
+		if s.Highlighted {
+			attrs = append(attrs, "color=red", "penwidth=2")
+		}
+		fmt.Fprintf(&b, "  %s [%s];\n", dotQuote(s.ID), strings.Join(attrs, ", "))
+	}
+
+	for _, t := range g.Transitions {
+		label := t.Template
+		if label == "" {
+			label = "ε"
+		}
+		if t.Condition != "" {
+			label += "\n[" + t.Condition + "]"
+		}
+		attrs := []string{"label=" + dotQuote(label)}
+		if t.Highlighted {
+			attrs = append(attrs, "color=red", "fontcolor=red", "penwidth=2")
+		}
+		fmt.Fprintf(&b, "  %s -> %s [%s];\n", dotQuote(t.From), dotQuote(t.To), strings.Join(attrs, ", "))
+	}
+
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// dotQuote quotes a DOT identifier or label
+func dotQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return `"` + s + `"`
+}
+
+// WriteDOT exports the graph in Graphviz DOT format, highlighting path if it
+// is not nil
+func (tm *TurkishMorphotactics) WriteDOT(w io.Writer, path SearchPathInterface) error {
+	return tm.ExportGraph(path).WriteDOT(w)
+}
+
+// WriteJSON exports the graph as JSON, highlighting path if it is not nil
+func (tm *TurkishMorphotactics) WriteJSON(w io.Writer, path SearchPathInterface) error {
+	return tm.ExportGraph(path).WriteJSON(w)
+}
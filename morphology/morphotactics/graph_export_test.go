@@ -0,0 +1,180 @@
+package morphotactics
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/kalaomer/zemberek-go/core/turkish"
+)
+
+type mockTransition struct {
+	lexical MorphemeTransition
+	state   *MorphemeState
+}
+
+func (m *mockTransition) GetState() *MorphemeState                 { return m.state }
+func (m *mockTransition) GetMorpheme() *Morpheme                   { return m.state.Morpheme }
+func (m *mockTransition) GetSurface() string                       { return "" }
+func (m *mockTransition) GetLexicalTransition() MorphemeTransition { return m.lexical }
+
+type transitionsPath struct {
+	mockPath
+	transitions []TransitionInterface
+}
+
+func (p *transitionsPath) GetTransitions() []TransitionInterface { return p.transitions }
+
+func findTransition(t *testing.T, from *MorphemeState, to *MorphemeState, template string) *SuffixTransition {
+	t.Helper()
+	for _, out := range from.Outgoing {
+		if st := out.(*SuffixTransition); st.To == to && st.SurfaceTemplate == template {
+			return st
+		}
+	}
+	t.Fatalf("no transition %s -> %s %s", from.ID, to.ID, template)
+	return nil
+}
+
+func TestConditionString(t *testing.T) {
+	lex := definitionTestLexicon()
+	p := NewMorphotacticsParser(lex)
+
+	for _, condition := range []string{
+		"Has(LastLetterVowel)",
+		"Has(Voicing)",
+		"Not(HasAnySuffixSurface)",
+		"And(HasTail, Not(Has(LastLetterVoiceless)))",
+		"Or(PreviousMorphemeIs(Neg), DictionaryItemIs(ev_Noun))",
+		"DictionaryItemIsAny(ev_Noun, gelmek_Verb)",
+		"DictionaryItemIsNone(güzel_Adj)",
+		"NoSurfaceAfterDerivation",
+	} {
+		c, err := p.ParseCondition(condition)
+		if err != nil {
+			t.Fatalf("ParseCondition(%q): %v", condition, err)
+		}
+		if got := ConditionString(c); got != condition {
+			t.Errorf("expected %q, got %q", condition, got)
+		}
+	}
+
+	// Template conditions are printed in the same syntax
+	tm := NewTurkishMorphotactics(lex)
+	st := findTransition(t, tm.PnonS, tm.DatST, "+yA")
+	if got := ConditionString(st.Condition); got != "Not(Has(ExpectsConsonant))" {
+		t.Errorf("unexpected template condition %q", got)
+	}
+	if ConditionString(nil) != "" {
+		t.Error("nil condition should print empty")
+	}
+}
+
+func TestExportGraph(t *testing.T) {
+	tm := NewTurkishMorphotactics(definitionTestLexicon())
+	g := tm.ExportGraph(nil)
+
+	states := make(map[string]GraphState)
+	for _, s := range g.States {
+		if _, dup := states[s.ID]; dup {
+			t.Errorf("state %s exported twice", s.ID)
+		}
+		states[s.ID] = s
+	}
+	for _, id := range []string{"root_S", "noun_S", "verbRoot_S", "dim_S", "vA3pl_ST"} {
+		if _, ok := states[id]; !ok {
+			t.Errorf("state %s missing", id)
+		}
+	}
+	if !states["nom_ST"].Terminal || !states["dim_S"].Derivative || !states["noun_S"].PosRoot {
+		t.Error("state flags not exported")
+	}
+	if g.PosRoots[turkish.Verb.GetStringForm()] != "verbRoot_S" {
+		t.Errorf("unexpected POS roots %v", g.PosRoots)
+	}
+
+	var dim *GraphTransition
+	for i, tr := range g.Transitions {
+		if tr.From == "nom_ST" && tr.To == "dim_S" && tr.Template == ">cI~k" {
+			dim = &g.Transitions[i]
+		}
+	}
+	if dim == nil {
+		t.Fatal("nom_ST -> dim_S transition missing")
+	}
+	if !strings.Contains(dim.Condition, "Not(HasAnySuffixSurface)") {
+		t.Errorf("condition not rendered: %q", dim.Condition)
+	}
+
+	var buf bytes.Buffer
+	if err := g.WriteJSON(&buf); err != nil {
+		t.Fatal(err)
+	}
+	var decoded Graph
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if len(decoded.States) != len(g.States) || len(decoded.Transitions) != len(g.Transitions) {
+		t.Error("JSON round trip lost data")
+	}
+
+	buf.Reset()
+	if err := tm.WriteDOT(&buf, nil); err != nil {
+		t.Fatal(err)
+	}
+	dot := buf.String()
+	if !strings.HasPrefix(dot, "digraph morphotactics {") || !strings.HasSuffix(dot, "}\n") {
+		t.Errorf("unexpected DOT output:\n%s", dot)
+	}
+	if !strings.Contains(dot, `"noun_S" -> "a3pl_S" [label="lAr`) {
+		t.Error("DOT output lacks noun_S -> a3pl_S edge")
+	}
+	if strings.Contains(dot, "color=red") {
+		t.Error("nothing should be highlighted without a path")
+	}
+}
+
+func TestExportGraphHighlight(t *testing.T) {
+	tm := NewTurkishMorphotactics(definitionTestLexicon())
+
+	// ev-ler-de: noun_S -> a3pl_S -> pnon_S -> loc_ST
+	toA3pl := findTransition(t, tm.NounS, tm.A3plS, "lAr")
+	toPnon := findTransition(t, tm.A3plS, tm.PnonS, "")
+	toLoc := findTransition(t, tm.PnonS, tm.LocST, ">dA")
+	path := &transitionsPath{transitions: []TransitionInterface{
+		&mockTransition{state: tm.NounS},
+		&mockTransition{lexical: toA3pl, state: tm.A3plS},
+		&mockTransition{lexical: toPnon, state: tm.PnonS},
+		&mockTransition{lexical: toLoc, state: tm.LocST},
+	}}
+
+	g := tm.ExportGraph(path)
+	highlighted := 0
+	for _, tr := range g.Transitions {
+		if tr.Highlighted {
+			highlighted++
+			if !(tr.From == "noun_S" && tr.To == "a3pl_S") && !(tr.From == "a3pl_S" && tr.To == "pnon_S") &&
+				!(tr.From == "pnon_S" && tr.To == "loc_ST") {
+				t.Errorf("unexpected highlighted transition %+v", tr)
+			}
+		}
+	}
+	if highlighted != 3 {
+		t.Errorf("expected 3 highlighted transitions, got %d", highlighted)
+	}
+	for _, s := range g.States {
+		expected := s.ID == "noun_S" || s.ID == "a3pl_S" || s.ID == "pnon_S" || s.ID == "loc_ST"
+		if s.Highlighted != expected {
+			t.Errorf("state %s highlighted=%v", s.ID, s.Highlighted)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := g.WriteDOT(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), `"pnon_S" -> "loc_ST" [label=">dA\n[Not(Has(ExpectsVowel))]", color=red`) {
+		t.Errorf("highlighted edge not found in DOT output:\n%s", buf.String())
+	}
+}
@@ -11,6 +11,9 @@ type Morpheme struct {
 	Informal        bool
 	Derivational    bool
 	Pos             turkish.PrimaryPos
+	// HasPos is set for POS morphemes. The zero PrimaryPos is Noun, so Pos
+	// alone does not tell whether a morpheme carries a POS.
+	HasPos          bool
 	MappedMorpheme  *Morpheme
 }
 
@@ -36,8 +39,9 @@ func NewMorpheme(name, id string) *Morpheme {
 func NewMorphemeWithPos(name, id string, pos turkish.PrimaryPos) *Morpheme {
 	return &Morpheme{
 		Name: name,
-		ID:   id,
-		Pos:  pos,
+		ID:     id,
+		Pos:    pos,
+		HasPos: true,
 	}
 }
 
@@ -62,6 +66,7 @@ type MorphemeBuilder struct {
 	derivational   bool
 	informal       bool
 	pos            turkish.PrimaryPos
+	hasPos         bool
 	mappedMorpheme *Morpheme
 }
 
@@ -88,6 +93,7 @@ func (mb *MorphemeBuilder) Derivational() *MorphemeBuilder {
 // WithPos sets the POS
 func (mb *MorphemeBuilder) WithPos(pos turkish.PrimaryPos) *MorphemeBuilder {
 	mb.pos = pos
+	mb.hasPos = true
 	return mb
 }
 
@@ -105,6 +111,7 @@ func (mb *MorphemeBuilder) Build() *Morpheme {
 		Informal:       mb.informal,
 		Derivational:   mb.derivational,
 		Pos:            mb.pos,
+		HasPos:         mb.hasPos,
 		MappedMorpheme: mb.mappedMorpheme,
 	}
 }
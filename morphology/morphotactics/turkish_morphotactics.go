@@ -1,6 +1,8 @@
 package morphotactics
 
 import (
+	"sort"
+
 	"github.com/kalaomer/zemberek-go/core/turkish"
 	"github.com/kalaomer/zemberek-go/morphology/lexicon"
 )
@@ -165,12 +167,30 @@ type TurkishMorphotactics struct {
 	VFutPartS *MorphemeState // Future participle
 	VProg1S   *MorphemeState // Progressive1 (-iyor)
 	VPastS    *MorphemeState // Past tense (-di)
-	VA1sgST   *MorphemeState // Verb A1sg terminal
-	VA2sgST   *MorphemeState // Verb A2sg terminal
-	VA3sgST   *MorphemeState // Verb A3sg terminal
-	VA1plST   *MorphemeState // Verb A1pl terminal
-	VA2plST   *MorphemeState // Verb A2pl terminal
-	VA3plST   *MorphemeState // Verb A3pl terminal
+	VNarrS    *MorphemeState // Narrative tense (-miş)
+	VCondS    *MorphemeState // Condition (-se)
+	VNecesS   *MorphemeState // Necessity (-meli)
+	VAorS     *MorphemeState // Aorist (-ir/-ar)
+	VOptS     *MorphemeState // Optative (-e/-a)
+
+	// Negative aorist (gel-me-z, gel-me-m)
+	VAorNegS      *MorphemeState
+	VAorNegEmptyS *MorphemeState
+
+	// Negative before progressive (gel-m-iyor)
+	VNegProg1S *MorphemeState
+
+	// Copula after tenses (geliyor-du, gelecek-miş, geliyor-sa)
+	VPastAfterTenseS *MorphemeState
+	VNarrAfterTenseS *MorphemeState
+	VCondAfterTenseS *MorphemeState
+
+	VA1sgST *MorphemeState // Verb A1sg terminal
+	VA2sgST *MorphemeState // Verb A2sg terminal
+	VA3sgST *MorphemeState // Verb A3sg terminal
+	VA1plST *MorphemeState // Verb A1pl terminal
+	VA2plST *MorphemeState // Verb A2pl terminal
+	VA3plST *MorphemeState // Verb A3pl terminal
 
 	// Additional POS root states (previously defaulted to noun)
 	AdverbRoot      *MorphemeState
@@ -179,12 +199,18 @@ type TurkishMorphotactics struct {
 	DeterminerRoot  *MorphemeState
 	PronounRoot     *MorphemeState
 	NumeralRoot     *MorphemeState
+	NumZeroS        *MorphemeState // Numerals used as nouns (3'e)
 	InterjRoot      *MorphemeState
 	QuestionRoot    *MorphemeState
 	DuplicatorRoot  *MorphemeState
 
 	// Stem transitions manager
 	stemTransitions *StemTransitionsMapBased
+
+	// All states by ID and the root state used for each primary POS
+	states      map[string]*MorphemeState
+	rootStates  map[turkish.PrimaryPos]*MorphemeState
+	defaultRoot *MorphemeState
 }
 
 // NewTurkishMorphotactics creates a new Turkish morphotactics system
@@ -265,6 +291,7 @@ func NewTurkishMorphotactics(lex *lexicon.RootLexicon) *TurkishMorphotactics {
 
 	tm.NumeralRoot = NewMorphemeStateTerminal("numeralRoot_ST", Num)
 	tm.NumeralRoot.PosRoot = true
+	tm.NumZeroS = NewMorphemeStateBuilder("numZero_S", Zero).SetDerivative(true).Build()
 
 	tm.InterjRoot = NewMorphemeStateTerminal("interjectionRoot_ST", Interj)
 	tm.InterjRoot.PosRoot = true
@@ -292,6 +319,17 @@ func NewTurkishMorphotactics(lex *lexicon.RootLexicon) *TurkishMorphotactics {
 	tm.VFutPartS = NewMorphemeStateBuilder("vFutPart_S", addMorpheme(NewDerivationalMorpheme("FutureParticiple", "FutPart"))).SetDerivative(true).Build()
 	tm.VProg1S = NewMorphemeStateNonTerminal("vProg1_S", Prog1)
 	tm.VPastS = NewMorphemeStateNonTerminal("vPast_S", Past)
+	tm.VNarrS = NewMorphemeStateNonTerminal("vNarr_S", Narr)
+	tm.VCondS = NewMorphemeStateNonTerminal("vCond_S", Cond)
+	tm.VNecesS = NewMorphemeStateNonTerminal("vNeces_S", Neces)
+	tm.VAorS = NewMorphemeStateNonTerminal("vAor_S", Aor)
+	tm.VOptS = NewMorphemeStateNonTerminal("vOpt_S", Opt)
+	tm.VAorNegS = NewMorphemeStateNonTerminal("vAorNeg_S", Aor)
+	tm.VAorNegEmptyS = NewMorphemeStateNonTerminal("vAorNegEmpty_S", Aor)
+	tm.VNegProg1S = NewMorphemeStateNonTerminal("vNegProg1_S", Neg)
+	tm.VPastAfterTenseS = NewMorphemeStateNonTerminal("vPastAfterTense_S", Past)
+	tm.VNarrAfterTenseS = NewMorphemeStateNonTerminal("vNarrAfterTense_S", Narr)
+	tm.VCondAfterTenseS = NewMorphemeStateNonTerminal("vCondAfterTense_S", Cond)
 
 	// Verb agreement terminals
 	tm.VA1sgST = NewMorphemeStateTerminal("vA1sg_ST", A1sg)
@@ -307,6 +345,24 @@ func NewTurkishMorphotactics(lex *lexicon.RootLexicon) *TurkishMorphotactics {
 	// Connect verb states
 	tm.connectVerbStates()
 
+	tm.registerNamedStates()
+	tm.rootStates = map[turkish.PrimaryPos]*MorphemeState{
+		turkish.Noun:         tm.NounS,
+		turkish.Adjective:    tm.AdjectiveRoot,
+		turkish.Verb:         tm.VerbRoot,
+		turkish.Adverb:       tm.AdverbRoot,
+		turkish.Conjunction:  tm.ConjunctionRoot,
+		turkish.PostPositive: tm.PostpRoot,
+		turkish.Determiner:   tm.DeterminerRoot,
+		turkish.Pronoun:      tm.PronounRoot,
+		turkish.Numeral:      tm.NumeralRoot,
+		turkish.Interjection: tm.InterjRoot,
+		turkish.Question:     tm.QuestionRoot,
+		turkish.Duplicator:   tm.DuplicatorRoot,
+		turkish.Punctuation:  tm.PuncRootST,
+	}
+	tm.defaultRoot = tm.NounS // Default to noun
+
 	// Initialize stem transitions
 	tm.stemTransitions = NewStemTransitionsMapBased(lex, tm)
 
@@ -327,7 +383,7 @@ func (tm *TurkishMorphotactics) connectNounStates() {
 	// A3sg -> Possession markers
 	NewSuffixTransitionBuilder(tm.A3sgS, tm.P1sgS).SetTemplate("Im").Build()   // -ım/-im/-um/-üm
 	NewSuffixTransitionBuilder(tm.A3sgS, tm.P2sgS).SetTemplate("In").Build()   // -ın/-in/-un/-ün
-	NewSuffixTransitionBuilder(tm.A3sgS, tm.P3sgS).SetTemplate("sI").Build()   // -sı/-si/-su/-sü
+	NewSuffixTransitionBuilder(tm.A3sgS, tm.P3sgS).SetTemplate("+sI").Build()  // -ı/-i, -sı/-si after vowels
 	NewSuffixTransitionBuilder(tm.A3sgS, tm.P1plS).SetTemplate("ImIz").Build() // -ımız/-imiz
 	NewSuffixTransitionBuilder(tm.A3sgS, tm.P2plS).SetTemplate("InIz").Build() // -ınız/-iniz
 	NewSuffixTransitionBuilder(tm.A3sgS, tm.P3plS).SetTemplate("lArI").Build() // -ları/-leri
@@ -441,6 +497,10 @@ func (tm *TurkishMorphotactics) connectNounStates() {
 
 	// acquire_S -> VerbRoot (becomes verb)
 	NewSuffixTransitionBuilder(tm.AcquireS, tm.VerbRoot).Empty().Build()
+
+	// numeralRoot_ST -> numZero_S -> noun_S (3'e, üçüncüsü)
+	NewSuffixTransitionBuilder(tm.NumeralRoot, tm.NumZeroS).Empty().Build()
+	NewSuffixTransitionBuilder(tm.NumZeroS, tm.NounS).Empty().Build()
 }
 
 // connectVerbStates connects verb morphotactic states
@@ -524,8 +584,57 @@ func (tm *TurkishMorphotactics) connectVerbStates() {
 	// VerbRoot -> Past tense (-di/-ti/-dı/-tı/-du/-tu/-dü/-tü)
 	NewSuffixTransitionBuilder(tm.VerbRoot, tm.VPastS).SetTemplate(">dI").Build()
 
+	// Negative progressive drops the vowel of -mA (gel-m-iyor)
+	NewSuffixTransitionBuilder(tm.VerbRoot, tm.VNegProg1S).SetTemplate("m").Build()
+	NewSuffixTransitionBuilder(tm.VNegProg1S, tm.VProg1S).SetTemplate("Iyor").Build()
+
+	// Narrative (-miş), condition (-se) and necessity (-meli), also after negative
+	NewSuffixTransitionBuilder(tm.VerbRoot, tm.VNarrS).SetTemplate("mIş").Build()
+	NewSuffixTransitionBuilder(tm.VNegS, tm.VNarrS).SetTemplate("mIş").Build()
+	NewSuffixTransitionBuilder(tm.VerbRoot, tm.VCondS).SetTemplate("sA").Build()
+	NewSuffixTransitionBuilder(tm.VNegS, tm.VCondS).SetTemplate("sA").Build()
+	NewSuffixTransitionBuilder(tm.VerbRoot, tm.VNecesS).SetTemplate("mAlI").Build()
+	NewSuffixTransitionBuilder(tm.VNegS, tm.VNecesS).SetTemplate("mAlI").Build()
+
+	// Aorist vowel comes from the lexicon (gelir, yapar). The negative aorist
+	// is -z except in the first person (gelmez, gelmem, gelmeyiz).
+	NewSuffixTransitionBuilder(tm.VerbRoot, tm.VAorS).SetTemplate("+Ir").SetCondition(&HasRootAttribute{Attribute: turkish.AoristI}).Build()
+	NewSuffixTransitionBuilder(tm.VerbRoot, tm.VAorS).SetTemplate("+Ar").SetCondition(&HasRootAttribute{Attribute: turkish.AoristA}).Build()
+	NewSuffixTransitionBuilder(tm.VNegS, tm.VAorNegS).SetTemplate("z").Build()
+	NewSuffixTransitionBuilder(tm.VNegS, tm.VAorNegEmptyS).Empty().Build()
+
+	// Optative (gele, gelmeye)
+	NewSuffixTransitionBuilder(tm.VerbRoot, tm.VOptS).SetTemplate("+yA").Build()
+	NewSuffixTransitionBuilder(tm.VNegS, tm.VOptS).SetTemplate("yA").Build()
+
+	// Copula after tenses (geliyordu, gelecekmiş, geliyorsa)
+	NewSuffixTransitionBuilder(tm.VProg1S, tm.VPastAfterTenseS).SetTemplate("dI").Build()
+	NewSuffixTransitionBuilder(tm.VProg1S, tm.VNarrAfterTenseS).SetTemplate("mIş").Build()
+	NewSuffixTransitionBuilder(tm.VProg1S, tm.VCondAfterTenseS).SetTemplate("sA").Build()
+	NewSuffixTransitionBuilder(tm.VNarrS, tm.VPastAfterTenseS).SetTemplate(">dI").Build()
+	NewSuffixTransitionBuilder(tm.VNarrS, tm.VCondAfterTenseS).SetTemplate("sA").Build()
+	NewSuffixTransitionBuilder(tm.VFutS, tm.VPastAfterTenseS).SetTemplate(">dI").Build()
+	NewSuffixTransitionBuilder(tm.VFutS, tm.VNarrAfterTenseS).SetTemplate("mIş").Build()
+	NewSuffixTransitionBuilder(tm.VFutS, tm.VCondAfterTenseS).SetTemplate("sA").Build()
+	NewSuffixTransitionBuilder(tm.VNecesS, tm.VPastAfterTenseS).SetTemplate("+ydI").Build()
+	NewSuffixTransitionBuilder(tm.VNecesS, tm.VNarrAfterTenseS).SetTemplate("+ymIş").Build()
+	NewSuffixTransitionBuilder(tm.VNecesS, tm.VCondAfterTenseS).SetTemplate("+ysA").Build()
+	NewSuffixTransitionBuilder(tm.VCondS, tm.VPastAfterTenseS).SetTemplate("+ydI").Build()
+	for _, from := range []*MorphemeState{tm.VAorS, tm.VAorNegS} {
+		NewSuffixTransitionBuilder(from, tm.VPastAfterTenseS).SetTemplate("dI").Build()
+		NewSuffixTransitionBuilder(from, tm.VNarrAfterTenseS).SetTemplate("mIş").Build()
+		NewSuffixTransitionBuilder(from, tm.VCondAfterTenseS).SetTemplate("sA").Build()
+	}
+	NewSuffixTransitionBuilder(tm.VOptS, tm.VPastAfterTenseS).SetTemplate("ydI").Build()
+	NewSuffixTransitionBuilder(tm.VOptS, tm.VNarrAfterTenseS).SetTemplate("ymIş").Build()
+
 	// vFut_S -> agreement terminals
+	NewSuffixTransitionBuilder(tm.VFutS, tm.VA1sgST).SetTemplate("Im").Build()
+	NewSuffixTransitionBuilder(tm.VFutS, tm.VA2sgST).SetTemplate("sIn").Build()
 	NewSuffixTransitionBuilder(tm.VFutS, tm.VA3sgST).Empty().Build()
+	NewSuffixTransitionBuilder(tm.VFutS, tm.VA1plST).SetTemplate("Iz").Build()
+	NewSuffixTransitionBuilder(tm.VFutS, tm.VA2plST).SetTemplate("sInIz").Build()
+	NewSuffixTransitionBuilder(tm.VFutS, tm.VA3plST).SetTemplate("lAr").Build()
 
 	// vProg1_S -> agreement terminals
 	NewSuffixTransitionBuilder(tm.VProg1S, tm.VA1sgST).SetTemplate("Im").Build()
@@ -543,6 +652,66 @@ func (tm *TurkishMorphotactics) connectVerbStates() {
 	NewSuffixTransitionBuilder(tm.VPastS, tm.VA2plST).SetTemplate("InIz").Build()
 	NewSuffixTransitionBuilder(tm.VPastS, tm.VA3plST).SetTemplate("lAr").Build()
 
+	// Narrative tense and copula -> agreement terminals
+	for _, from := range []*MorphemeState{tm.VNarrS, tm.VNarrAfterTenseS} {
+		NewSuffixTransitionBuilder(from, tm.VA1sgST).SetTemplate("Im").Build()
+		NewSuffixTransitionBuilder(from, tm.VA2sgST).SetTemplate("sIn").Build()
+		NewSuffixTransitionBuilder(from, tm.VA3sgST).Empty().Build()
+		NewSuffixTransitionBuilder(from, tm.VA1plST).SetTemplate("Iz").Build()
+		NewSuffixTransitionBuilder(from, tm.VA2plST).SetTemplate("sInIz").Build()
+		NewSuffixTransitionBuilder(from, tm.VA3plST).SetTemplate("lAr").Build()
+	}
+
+	// Past copula -> agreement terminals
+	NewSuffixTransitionBuilder(tm.VPastAfterTenseS, tm.VA1sgST).SetTemplate("m").Build()
+	NewSuffixTransitionBuilder(tm.VPastAfterTenseS, tm.VA2sgST).SetTemplate("n").Build()
+	NewSuffixTransitionBuilder(tm.VPastAfterTenseS, tm.VA3sgST).Empty().Build()
+	NewSuffixTransitionBuilder(tm.VPastAfterTenseS, tm.VA1plST).SetTemplate("k").Build()
+	NewSuffixTransitionBuilder(tm.VPastAfterTenseS, tm.VA2plST).SetTemplate("nIz").Build()
+	NewSuffixTransitionBuilder(tm.VPastAfterTenseS, tm.VA3plST).SetTemplate("lAr").Build()
+
+	// Condition and conditional copula -> agreement terminals
+	for _, from := range []*MorphemeState{tm.VCondS, tm.VCondAfterTenseS} {
+		NewSuffixTransitionBuilder(from, tm.VA1sgST).SetTemplate("m").Build()
+		NewSuffixTransitionBuilder(from, tm.VA2sgST).SetTemplate("n").Build()
+		NewSuffixTransitionBuilder(from, tm.VA3sgST).Empty().Build()
+		NewSuffixTransitionBuilder(from, tm.VA1plST).SetTemplate("k").Build()
+		NewSuffixTransitionBuilder(from, tm.VA2plST).SetTemplate("nIz").Build()
+		NewSuffixTransitionBuilder(from, tm.VA3plST).SetTemplate("lAr").Build()
+	}
+
+	// vNeces_S -> agreement terminals
+	NewSuffixTransitionBuilder(tm.VNecesS, tm.VA1sgST).SetTemplate("yIm").Build()
+	NewSuffixTransitionBuilder(tm.VNecesS, tm.VA2sgST).SetTemplate("sIn").Build()
+	NewSuffixTransitionBuilder(tm.VNecesS, tm.VA3sgST).Empty().Build()
+	NewSuffixTransitionBuilder(tm.VNecesS, tm.VA1plST).SetTemplate("yIz").Build()
+	NewSuffixTransitionBuilder(tm.VNecesS, tm.VA2plST).SetTemplate("sInIz").Build()
+	NewSuffixTransitionBuilder(tm.VNecesS, tm.VA3plST).SetTemplate("lAr").Build()
+
+	// vAor_S -> agreement terminals
+	NewSuffixTransitionBuilder(tm.VAorS, tm.VA1sgST).SetTemplate("Im").Build()
+	NewSuffixTransitionBuilder(tm.VAorS, tm.VA2sgST).SetTemplate("sIn").Build()
+	NewSuffixTransitionBuilder(tm.VAorS, tm.VA3sgST).Empty().Build()
+	NewSuffixTransitionBuilder(tm.VAorS, tm.VA1plST).SetTemplate("Iz").Build()
+	NewSuffixTransitionBuilder(tm.VAorS, tm.VA2plST).SetTemplate("sInIz").Build()
+	NewSuffixTransitionBuilder(tm.VAorS, tm.VA3plST).SetTemplate("lAr").Build()
+
+	// Negative aorist -> agreement terminals
+	NewSuffixTransitionBuilder(tm.VAorNegS, tm.VA2sgST).SetTemplate("sIn").Build()
+	NewSuffixTransitionBuilder(tm.VAorNegS, tm.VA3sgST).Empty().Build()
+	NewSuffixTransitionBuilder(tm.VAorNegS, tm.VA2plST).SetTemplate("sInIz").Build()
+	NewSuffixTransitionBuilder(tm.VAorNegS, tm.VA3plST).SetTemplate("lAr").Build()
+	NewSuffixTransitionBuilder(tm.VAorNegEmptyS, tm.VA1sgST).SetTemplate("m").Build()
+	NewSuffixTransitionBuilder(tm.VAorNegEmptyS, tm.VA1plST).SetTemplate("yIz").Build()
+
+	// vOpt_S -> agreement terminals
+	NewSuffixTransitionBuilder(tm.VOptS, tm.VA1sgST).SetTemplate("yIm").Build()
+	NewSuffixTransitionBuilder(tm.VOptS, tm.VA2sgST).SetTemplate("sIn").Build()
+	NewSuffixTransitionBuilder(tm.VOptS, tm.VA3sgST).Empty().Build()
+	NewSuffixTransitionBuilder(tm.VOptS, tm.VA1plST).SetTemplate("lIm").Build()
+	NewSuffixTransitionBuilder(tm.VOptS, tm.VA2plST).SetTemplate("sInIz").Build()
+	NewSuffixTransitionBuilder(tm.VOptS, tm.VA3plST).SetTemplate("lAr").Build()
+
 	// vFutPart_S -> adjective (future participle becomes adjective)
 	NewSuffixTransitionBuilder(tm.VFutPartS, tm.AdjectiveRoot).Empty().Build()
 }
@@ -561,35 +730,119 @@ func (tm *TurkishMorphotactics) GetStemTransitions() *StemTransitionsMapBased {
 func (tm *TurkishMorphotactics) GetRootState(item *lexicon.DictionaryItem,
 	phoneticAttrs map[turkish.PhoneticAttribute]bool) *MorphemeState {
 
-	switch item.PrimaryPos {
-	case turkish.Noun:
-		return tm.NounS
-	case turkish.Adjective:
-		return tm.AdjectiveRoot
-	case turkish.Verb:
-		return tm.VerbRoot
-	case turkish.Adverb:
-		return tm.AdverbRoot
-	case turkish.Conjunction:
-		return tm.ConjunctionRoot
-	case turkish.PostPositive:
-		return tm.PostpRoot
-	case turkish.Determiner:
-		return tm.DeterminerRoot
-	case turkish.Pronoun:
-		return tm.PronounRoot
-	case turkish.Numeral:
-		return tm.NumeralRoot
-	case turkish.Interjection:
-		return tm.InterjRoot
-	case turkish.Question:
-		return tm.QuestionRoot
-	case turkish.Duplicator:
-		return tm.DuplicatorRoot
-	case turkish.Punctuation:
-		return tm.PuncRootST
-	default:
-		return tm.NounS // Default to noun
+	if state, ok := tm.rootStates[item.PrimaryPos]; ok {
+		return state
+	}
+	return tm.defaultRoot
+}
+
+// GetState returns the state with the given ID, or nil
+func (tm *TurkishMorphotactics) GetState(id string) *MorphemeState {
+	return tm.states[id]
+}
+
+// GetStates returns all states ordered by ID
+func (tm *TurkishMorphotactics) GetStates() []*MorphemeState {
+	states := make([]*MorphemeState, 0, len(tm.states))
+	for _, state := range tm.states {
+		states = append(states, state)
+	}
+	sort.Slice(states, func(i, j int) bool { return states[i].ID < states[j].ID })
+	return states
+}
+
+// GetPosRootStates returns the root state used for each primary POS
+func (tm *TurkishMorphotactics) GetPosRootStates() map[turkish.PrimaryPos]*MorphemeState {
+	return tm.rootStates
+}
+
+// namedStates maps well known state IDs to the exported state fields, so
+// that graphs loaded from a definition file expose the same fields as the
+// built-in one
+func (tm *TurkishMorphotactics) namedStates() map[string]**MorphemeState {
+	return map[string]**MorphemeState{
+		"root_S":              &tm.RootS,
+		"puncRoot_ST":         &tm.PuncRootST,
+		"noun_S":              &tm.NounS,
+		"a3sg_S":              &tm.A3sgS,
+		"a3pl_S":              &tm.A3plS,
+		"pnon_S":              &tm.PnonS,
+		"p1sg_S":              &tm.P1sgS,
+		"p2sg_S":              &tm.P2sgS,
+		"p3sg_S":              &tm.P3sgS,
+		"p1pl_S":              &tm.P1plS,
+		"p2pl_S":              &tm.P2plS,
+		"p3pl_S":              &tm.P3plS,
+		"nounInf1Root_S":      &tm.NounInf1Root,
+		"a3sgInf1_S":          &tm.A3sgInf1S,
+		"pnonInf1_S":          &tm.PnonInf1S,
+		"nom_ST":              &tm.NomST,
+		"nom_S":               &tm.NomS,
+		"dat_ST":              &tm.DatST,
+		"abl_ST":              &tm.AblST,
+		"loc_ST":              &tm.LocST,
+		"ins_ST":              &tm.InsST,
+		"acc_ST":              &tm.AccST,
+		"gen_ST":              &tm.GenST,
+		"equ_ST":              &tm.EquST,
+		"rel_S":               &tm.RelS,
+		"dim_S":               &tm.DimS,
+		"without_S":           &tm.WithoutS,
+		"ness_S":              &tm.NessS,
+		"acquire_S":           &tm.AcquireS,
+		"adjectiveRoot_ST":    &tm.AdjectiveRoot,
+		"verbRoot_S":          &tm.VerbRoot,
+		"vPass_S":             &tm.VPassS,
+		"vPresPart_S":         &tm.VPresPartS,
+		"vPastPart_S":         &tm.VPastPartS,
+		"vInf1_S":             &tm.VInf1S,
+		"vInf2_S":             &tm.VInf2S,
+		"vByDoingSo_S":        &tm.VByDoingSoS,
+		"vAfterDoing_S":       &tm.VAfterDoingS,
+		"vAgt_S":              &tm.VAgtS,
+		"vNeg_S":              &tm.VNegS,
+		"vCaus_S":             &tm.VCausS,
+		"vFut_S":              &tm.VFutS,
+		"vFutPart_S":          &tm.VFutPartS,
+		"vProg1_S":            &tm.VProg1S,
+		"vPast_S":             &tm.VPastS,
+		"vNarr_S":             &tm.VNarrS,
+		"vCond_S":             &tm.VCondS,
+		"vNeces_S":            &tm.VNecesS,
+		"vAor_S":              &tm.VAorS,
+		"vOpt_S":              &tm.VOptS,
+		"vAorNeg_S":           &tm.VAorNegS,
+		"vAorNegEmpty_S":      &tm.VAorNegEmptyS,
+		"vNegProg1_S":         &tm.VNegProg1S,
+		"vPastAfterTense_S":   &tm.VPastAfterTenseS,
+		"vNarrAfterTense_S":   &tm.VNarrAfterTenseS,
+		"vCondAfterTense_S":   &tm.VCondAfterTenseS,
+		"vA1sg_ST":            &tm.VA1sgST,
+		"vA2sg_ST":            &tm.VA2sgST,
+		"vA3sg_ST":            &tm.VA3sgST,
+		"vA1pl_ST":            &tm.VA1plST,
+		"vA2pl_ST":            &tm.VA2plST,
+		"vA3pl_ST":            &tm.VA3plST,
+		"adverbRoot_ST":       &tm.AdverbRoot,
+		"conjunctionRoot_ST":  &tm.ConjunctionRoot,
+		"postpRoot_ST":        &tm.PostpRoot,
+		"determinerRoot_ST":   &tm.DeterminerRoot,
+		"pronounRoot_ST":      &tm.PronounRoot,
+		"numeralRoot_ST":      &tm.NumeralRoot,
+		"numZero_S":           &tm.NumZeroS,
+		"interjectionRoot_ST": &tm.InterjRoot,
+		"questionRoot_ST":     &tm.QuestionRoot,
+		"duplicatorRoot_ST":   &tm.DuplicatorRoot,
+	}
+}
+
+// registerNamedStates fills the state index from the exported state fields
+func (tm *TurkishMorphotactics) registerNamedStates() {
+	tm.states = make(map[string]*MorphemeState)
+	for id, field := range tm.namedStates() {
+		if *field != nil {
+			tm.states[id] = *field
+		}
 	}
 }
 
@@ -598,6 +851,7 @@ type StemTransitionsMapBased struct {
 	lexicon       *lexicon.RootLexicon
 	morphotactics *TurkishMorphotactics
 	transitionMap map[string][]*StemTransition
+	itemMap       map[*lexicon.DictionaryItem][]*StemTransition
 }
 
 // NewStemTransitionsMapBased creates a new stem transitions manager
@@ -606,6 +860,7 @@ func NewStemTransitionsMapBased(lex *lexicon.RootLexicon, morphotactics *Turkish
 		lexicon:       lex,
 		morphotactics: morphotactics,
 		transitionMap: make(map[string][]*StemTransition),
+		itemMap:       make(map[*lexicon.DictionaryItem][]*StemTransition),
 	}
 
 	// Add all lexicon items
@@ -620,20 +875,23 @@ func NewStemTransitionsMapBased(lex *lexicon.RootLexicon, morphotactics *Turkish
 
 // AddDictionaryItem adds a dictionary item to stem transitions
 func (stm *StemTransitionsMapBased) AddDictionaryItem(item *lexicon.DictionaryItem) {
+	for _, transition := range stm.generateTransitions(item) {
+		stm.AddStemTransition(transition)
+	}
+}
+
+// generateTransitions creates the stem transitions of an item
+func (stm *StemTransitionsMapBased) generateTransitions(item *lexicon.DictionaryItem) []*StemTransition {
 	// Check if item has modifier attributes (Voicing, Doubling, etc.)
 	if stm.hasModifierAttribute(item) {
 		// Generate modified root nodes (original + modified stems)
-		transitions := stm.generateModifiedRootNodes(item)
-		for _, transition := range transitions {
-			stm.AddStemTransition(transition)
-		}
-	} else {
-		// Simple case: single stem transition
-		phoneticAttrs := GetPhoneticAttributes(item.Root, nil)
-		rootState := stm.morphotactics.GetRootState(item, phoneticAttrs)
-		stemTransition := NewStemTransition(item.Root, item, phoneticAttrs, rootState)
-		stm.AddStemTransition(stemTransition)
+		return stm.generateModifiedRootNodes(item)
 	}
+
+	// Simple case: single stem transition
+	phoneticAttrs := GetPhoneticAttributes(item.Root, nil)
+	rootState := stm.morphotactics.GetRootState(item, phoneticAttrs)
+	return []*StemTransition{NewStemTransition(item.Root, item, phoneticAttrs, rootState)}
 }
 
 // AddStemTransition adds a stem transition to the map
@@ -645,6 +903,7 @@ func (stm *StemTransitionsMapBased) AddStemTransition(st *StemTransition) {
 	if item == nil {
 		return
 	}
+	stm.itemMap[item] = append(stm.itemMap[item], st)
 
 	if item.SecondaryPos == turkish.ProperNoun {
 		lowerSurface := turkish.Instance.ToLower(surface)
@@ -707,6 +966,15 @@ func (stm *StemTransitionsMapBased) GetTransitions(surface string) []*StemTransi
 	return make([]*StemTransition, 0)
 }
 
+// GetTransitionsForItem returns the stem transitions of a dictionary item.
+// Transitions are created on the fly for items that were not added.
+func (stm *StemTransitionsMapBased) GetTransitionsForItem(item *lexicon.DictionaryItem) []*StemTransition {
+	if transitions, exists := stm.itemMap[item]; exists {
+		return transitions
+	}
+	return stm.generateTransitions(item)
+}
+
 // GetPhoneticAttributes calculates phonetic attributes for a sequence
 func GetPhoneticAttributes(seq string, predecessorAttrs map[turkish.PhoneticAttribute]bool) map[turkish.PhoneticAttribute]bool {
 	if predecessorAttrs == nil {
@@ -0,0 +1,44 @@
+package morphology
+
+import (
+	"testing"
+
+	"github.com/kalaomer/zemberek-go/core/turkish"
+	"github.com/kalaomer/zemberek-go/morphology/lexicon"
+	"github.com/kalaomer/zemberek-go/morphology/morphotactics"
+)
+
+func TestMorphotacticsDefinitionAnalysis(t *testing.T) {
+	attrs := map[turkish.RootAttribute]bool{turkish.Voicing: true}
+	aoristI := map[turkish.RootAttribute]bool{turkish.AoristI: true}
+	lex := lexicon.NewRootLexicon([]*lexicon.DictionaryItem{
+		lexicon.NewDictionaryItem("kitap", "kitap", turkish.Noun, turkish.NonePos, attrs, "", 0),
+		lexicon.NewDictionaryItem("ev", "ev", turkish.Noun, turkish.NonePos, nil, "", 0),
+		lexicon.NewDictionaryItem("gelmek", "gel", turkish.Verb, turkish.NonePos, aoristI, "", 0),
+		lexicon.NewDictionaryItem("güzel", "güzel", turkish.Adjective, turkish.NonePos, nil, "", 0),
+	})
+
+	parsed, err := morphotactics.NewTurkishMorphotacticsFromDefinition(lex)
+	if err != nil {
+		t.Fatalf("NewTurkishMorphotacticsFromDefinition: %v", err)
+	}
+	builtIn := NewBuilder(lex).Build()
+	fromDefinition := NewBuilder(lex).UseMorphotactics(parsed).Build()
+
+	for _, word := range []string{"kitabımızdan", "evlerde", "evdeki", "geliyorum", "gelmedik", "gelirdim", "gelmezler", "geleyim", "güzellik", "kitapçık"} {
+		expected := builtIn.Analyze(word).AnalysisResults
+		if len(expected) == 0 {
+			t.Errorf("%s: no analysis with built-in morphotactics", word)
+		}
+		actual := fromDefinition.Analyze(word).AnalysisResults
+		if len(actual) != len(expected) {
+			t.Errorf("%s: got %d analyses, expected %d", word, len(actual), len(expected))
+			continue
+		}
+		for i := range expected {
+			if actual[i].FormatString() != expected[i].FormatString() {
+				t.Errorf("%s: got %s, expected %s", word, actual[i].FormatString(), expected[i].FormatString())
+			}
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package morphology
+
+import (
+	"strings"
+
+	"github.com/kalaomer/zemberek-go/core/turkish"
+	"github.com/kalaomer/zemberek-go/morphology/analysis"
+	"github.com/kalaomer/zemberek-go/morphology/lexicon"
+	"github.com/kalaomer/zemberek-go/morphology/morphotactics"
+)
+
+// analyzeNumber analyzes a number written with digits and optional suffixes
+// after an apostrophe, e.g. "3'e", "3.'sü" or "%40'ı". Suffixes follow the
+// harmony of the number read aloud. Returns nil if word is not a number. A
+// trace of the search is returned if traced is set.
+func (tm *TurkishMorphology) analyzeNumber(word string, traced bool) ([]*analysis.SingleAnalysis, *analysis.AnalysisTrace) {
+	number, ending, _ := strings.Cut(word, "'")
+	if !turkish.IsNumber(number) {
+		return nil, nil
+	}
+	words, _ := turkish.ReadNumber(number)
+	pronunciation := words[strings.LastIndexByte(words, ' ')+1:]
+
+	secondaryPos := turkish.Cardinal
+	switch {
+	case strings.HasPrefix(number, "%"):
+		secondaryPos = turkish.Percentage
+	case strings.Contains(number, ","):
+		secondaryPos = turkish.Real
+	case strings.HasSuffix(number, "."):
+		secondaryPos = turkish.Ordinal
+	}
+	item := lexicon.NewDictionaryItem(number, number, turkish.Numeral, secondaryPos, nil, pronunciation, 0)
+	attrs := morphotactics.GetPhoneticAttributes(pronunciation, nil)
+	stem := morphotactics.NewStemTransition(number, item, attrs, tm.Morphotactics.NumeralRoot)
+
+	initial := []*analysis.SearchPath{analysis.InitialPath(stem, ending)}
+	var paths []*analysis.SearchPath
+	var trace *analysis.AnalysisTrace
+	if traced {
+		paths, trace = tm.Analyzer.SearchWithTrace(initial)
+		trace.Input = word
+	} else {
+		paths = tm.Analyzer.Search(initial)
+	}
+	results := make([]*analysis.SingleAnalysis, 0, len(paths))
+	for _, path := range paths {
+		results = append(results, analysis.FromSearchPath(path))
+	}
+	return results, trace
+}
@@ -0,0 +1,46 @@
+package morphology
+
+import (
+	"testing"
+
+	"github.com/kalaomer/zemberek-go/core/turkish"
+	"github.com/kalaomer/zemberek-go/morphology/lexicon"
+)
+
+func TestAnalyzeNumber(t *testing.T) {
+	morph := NewBuilder(lexicon.NewRootLexicon(nil)).Build()
+	tests := []struct {
+		word         string
+		ending       string
+		secondaryPos turkish.SecondaryPos
+	}{
+		{"3'e", "e", turkish.Cardinal},
+		{"5'te", "te", turkish.Cardinal},
+		{"40'tan", "tan", turkish.Cardinal},
+		{"1.000'e", "e", turkish.Cardinal},
+		{"3.'sü", "sü", turkish.Ordinal},
+		{"2,5'i", "i", turkish.Real},
+		{"%60'a", "a", turkish.Percentage},
+		{"10", "", turkish.Cardinal},
+	}
+	for _, tt := range tests {
+		results := morph.Analyze(tt.word).AnalysisResults
+		if len(results) == 0 {
+			t.Errorf("%s: no analysis", tt.word)
+			continue
+		}
+		sa := results[0]
+		if sa.Item.PrimaryPos != turkish.Numeral || sa.Item.SecondaryPos != tt.secondaryPos {
+			t.Errorf("%s: unexpected item %s", tt.word, sa.Item.ID)
+		}
+		if got := sa.GetEnding(); got != tt.ending {
+			t.Errorf("%s: ending %q, want %q", tt.word, got, tt.ending)
+		}
+	}
+
+	for _, word := range []string{"3'a", "5'de", "3.'si"} {
+		if results := morph.Analyze(word).AnalysisResults; len(results) != 0 {
+			t.Errorf("%s: unexpected analysis %s", word, results[0].FormatString())
+		}
+	}
+}
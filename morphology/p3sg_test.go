@@ -0,0 +1,35 @@
+package morphology
+
+import (
+	"testing"
+
+	"github.com/kalaomer/zemberek-go/morphology/morphotactics"
+)
+
+// TestP3sgSuffix tests that P3sg is -sI after vowels and -I after consonants
+func TestP3sgSuffix(t *testing.T) {
+	morph := testMorphology()
+
+	for word, format := range map[string]string{
+		"evi":      "[ev:Noun] ev:Noun+A3sg+i:P3sg",
+		"gözü":     "[göz:Noun] göz:Noun+A3sg+ü:P3sg",
+		"kitabı":   "[kitap:Noun] kitab:Noun+A3sg+ı:P3sg",
+		"kitabına": "[kitap:Noun] kitab:Noun+A3sg+ı:P3sg+na:Dat",
+		"arabası":  "[araba:Noun] araba:Noun+A3sg+sı:P3sg",
+	} {
+		findAnalysis(t, morph, word, format)
+	}
+	for _, word := range []string{"evsi", "kitapsı", "arabaı"} {
+		if results := morph.Analyze(word).AnalysisResults; len(results) != 0 {
+			t.Errorf("%s: unexpected analyses %v", word, results)
+		}
+	}
+
+	for id, want := range map[string]string{"kitap_Noun": "kitabı", "araba_Noun": "arabası"} {
+		results := morph.WordGenerator.Generate(morph.Lexicon.GetItemByID(id),
+			[]*morphotactics.Morpheme{morphotactics.Noun, morphotactics.A3sg, morphotactics.P3sg})
+		if len(results) != 1 || results[0].Surface != want {
+			t.Errorf("%s+P3sg: unexpected results %v, want %s", id, results, want)
+		}
+	}
+}
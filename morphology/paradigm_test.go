@@ -0,0 +1,142 @@
+package morphology
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kalaomer/zemberek-go/morphology/generator"
+	"github.com/kalaomer/zemberek-go/morphology/morphotactics"
+)
+
+func TestWordGeneratorGenerate(t *testing.T) {
+	morph := testMorphology()
+	item := morph.Lexicon.GetItemByID("kitap_Noun")
+
+	results := morph.WordGenerator.Generate(item,
+		[]*morphotactics.Morpheme{morphotactics.Noun, morphotactics.A3pl, morphotactics.P1sg, morphotactics.Abl})
+	if len(results) != 1 || results[0].Surface != "kitaplarımdan" {
+		t.Fatalf("unexpected results %v", results)
+	}
+	if results[0].Analysis.FormatString() != "[kitap:Noun] kitap:Noun+lar:A3pl+ım:P1sg+dan:Abl" {
+		t.Errorf("unexpected analysis %s", results[0].Analysis.FormatString())
+	}
+
+	// Morphemes of an analysis generate the analysed word
+	a := findAnalysis(t, morph, "kitabıma", "[kitap:Noun] kitab:Noun+A3sg+ım:P1sg+a:Dat")
+	results = morph.WordGenerator.Generate(a.Item, a.GetMorphemes())
+	if len(results) != 1 || results[0].Surface != "kitabıma" {
+		t.Errorf("unexpected results %v", results)
+	}
+}
+
+func TestGenerateParadigm(t *testing.T) {
+	morph := testMorphology()
+
+	tests := []struct {
+		item     string
+		features []string
+		surface  string
+	}{
+		{"kitap_Noun", []string{"Sg", "None", "Nom"}, "kitap"},
+		{"kitap_Noun", []string{"Sg", "None", "Dat"}, "kitaba"},
+		{"kitap_Noun", []string{"Sg", "1Sg", "Nom"}, "kitabım"},
+		{"kitap_Noun", []string{"Sg", "3Sg", "Nom"}, "kitabı"},
+		{"kitap_Noun", []string{"Pl", "1Pl", "Abl"}, "kitaplarımızdan"},
+		{"göz_Noun", []string{"Sg", "1Sg", "Ins"}, "gözümle"},
+		{"göz_Noun", []string{"Sg", "3Sg", "Ins"}, "gözüyle"},
+		{"gelmek_Verb", []string{"Pos", "Past", "None", "1Sg"}, "geldim"},
+		{"gelmek_Verb", []string{"Neg", "Prog", "None", "3Sg"}, "gelmiyor"},
+		{"gelmek_Verb", []string{"Pos", "Prog", "Past", "1Pl"}, "geliyorduk"},
+		{"gelmek_Verb", []string{"Pos", "Fut", "None", "1Sg"}, "geleceğim"},
+		{"gelmek_Verb", []string{"Neg", "Fut", "Narr", "3Sg"}, "gelmeyecekmiş"},
+		{"gelmek_Verb", []string{"Pos", "Narr", "Cond", "2Sg"}, "gelmişsen"},
+		{"gelmek_Verb", []string{"Neg", "Neces", "None", "1Pl"}, "gelmemeliyiz"},
+		{"gelmek_Verb", []string{"Pos", "Cond", "Past", "3Sg"}, "gelseydi"},
+		{"gelmek_Verb", []string{"Pos", "Aor", "None", "1Sg"}, "gelirim"},
+		{"gelmek_Verb", []string{"Pos", "Aor", "Past", "3Pl"}, "gelirdiler"},
+		{"gelmek_Verb", []string{"Neg", "Aor", "None", "1Sg"}, "gelmem"},
+		{"gelmek_Verb", []string{"Neg", "Aor", "None", "3Sg"}, "gelmez"},
+		{"gelmek_Verb", []string{"Neg", "Aor", "None", "1Pl"}, "gelmeyiz"},
+		{"gelmek_Verb", []string{"Neg", "Aor", "Narr", "2Sg"}, "gelmezmişsin"},
+		{"gelmek_Verb", []string{"Pos", "Opt", "None", "1Pl"}, "gelelim"},
+		{"gelmek_Verb", []string{"Neg", "Opt", "None", "3Sg"}, "gelmeye"},
+		{"gelmek_Verb", []string{"Pos", "Opt", "Past", "1Sg"}, "geleydim"},
+		{"okumak_Verb", []string{"Pos", "Opt", "None", "1Sg"}, "okuyayım"},
+	}
+
+	paradigms := make(map[string]*generator.Paradigm)
+	for _, tt := range tests {
+		p := paradigms[tt.item]
+		if p == nil {
+			var err error
+			p, err = morph.WordGenerator.GenerateParadigm(morph.Lexicon.GetItemByID(tt.item), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			paradigms[tt.item] = p
+		}
+		cell := p.Cell(tt.features...)
+		if cell == nil {
+			t.Errorf("%s: no cell %v", tt.item, tt.features)
+			continue
+		}
+		if surfaces := cell.Surfaces(); len(surfaces) != 1 || surfaces[0] != tt.surface {
+			t.Errorf("%s %s: expected %s, got %v", tt.item, cell.Key(), tt.surface, surfaces)
+		}
+	}
+
+	// Every generated form analyses back to its analysis
+	for id, p := range paradigms {
+		if len(p.Forms()) == 0 {
+			t.Errorf("%s: no forms", id)
+		}
+		for _, r := range p.Forms() {
+			found := false
+			for _, a := range morph.Analyze(r.Surface).AnalysisResults {
+				if a.FormatString() == r.Analysis.FormatString() {
+					found = true
+					break
+				}
+			}
+			if !found {
+				t.Errorf("%s: %s does not analyse as %s", id, r.Surface, r.Analysis.FormatString())
+			}
+		}
+	}
+
+	if len(paradigms["kitap_Noun"].Missing()) != 0 {
+		t.Errorf("missing noun forms %v", paradigms["kitap_Noun"].Missing())
+	}
+}
+
+func TestParadigmSpec(t *testing.T) {
+	morph := testMorphology()
+	item := morph.Lexicon.GetItemByID("kitap_Noun")
+
+	spec := generator.NounParadigmSpec().Select("Case", "Nom", "Dat").Without("Possession")
+	if spec.Size() != 4 {
+		t.Fatalf("expected 4 cells, got %d", spec.Size())
+	}
+	p, err := morph.WordGenerator.GenerateParadigm(item, spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sb strings.Builder
+	if _, err := p.WriteTo(&sb); err != nil {
+		t.Fatal(err)
+	}
+	expected := "Number\tCase\tForm\n" +
+		"Sg\tNom\tkitap\n" +
+		"Sg\tDat\tkitaba\n" +
+		"Pl\tNom\tkitaplar\n" +
+		"Pl\tDat\tkitaplara\n"
+	if sb.String() != expected {
+		t.Errorf("unexpected table:\n%s", sb.String())
+	}
+
+	spec = generator.VerbParadigmSpec()
+	spec.MaxCells = 10
+	if _, err := morph.WordGenerator.GenerateParadigm(morph.Lexicon.GetItemByID("gelmek_Verb"), spec); err == nil {
+		t.Error("expected error for a spec over the cell limit")
+	}
+}
@@ -0,0 +1,101 @@
+package morphology
+
+import (
+	"fmt"
+	"unicode"
+
+	"github.com/kalaomer/zemberek-go/core/turkish"
+	"github.com/kalaomer/zemberek-go/morphology/analysis"
+	"github.com/kalaomer/zemberek-go/morphology/lexicon"
+)
+
+// Reinflect returns the form of target that carries the suffixes of word, e.g.
+// "kitaplarımızdan" with "defter" gives "defterlerimizden" and "Ankara'ya"
+// with "İzmir" gives "İzmir'e". target is a dictionary item ID or lemma.
+// Analyses of word are tried in order and the first one that can be
+// generated on a target item is used. A capitalized word that is not a proper
+// noun, e.g. at the start of a sentence, gives a capitalized result.
+func (tm *TurkishMorphology) Reinflect(word, target string) (string, error) {
+	var items []*lexicon.DictionaryItem
+	if item := tm.Lexicon.GetItemByID(target); item != nil {
+		items = append(items, item)
+	} else {
+		items = tm.Lexicon.GetItems(target)
+	}
+	if len(items) == 0 {
+		return "", fmt.Errorf("morphology: no dictionary item for %q", target)
+	}
+
+	wa := tm.Analyze(word)
+	if len(wa.AnalysisResults) == 0 {
+		return "", fmt.Errorf("morphology: no analysis for %q", word)
+	}
+
+	for _, sa := range wa.AnalysisResults {
+		for _, item := range items {
+			if forms := tm.ReinflectAnalysis(sa, item); len(forms) > 0 {
+				result := forms[0]
+				if startsWithUpper(word) && sa.Item.SecondaryPos != turkish.ProperNoun {
+					result = capitalizeFirst(result)
+				}
+				return result, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("morphology: %q can not be inflected like %q", target, word)
+}
+
+// ReinflectAnalysis generates target with the morphemes of sa. Voicing and
+// vowel harmony follow the target stem. Proper noun and abbreviation forms
+// are written with the lemma and an apostrophe before the suffixes. Returns
+// the distinct forms, or nil if the morphemes can not follow target.
+func (tm *TurkishMorphology) ReinflectAnalysis(sa *analysis.SingleAnalysis, target *lexicon.DictionaryItem) []string {
+	var forms []string
+	for _, r := range tm.WordGenerator.Generate(target, sa.GetMorphemes()) {
+		form := r.Surface
+		if target.SecondaryPos == turkish.ProperNoun || target.SecondaryPos == turkish.Abbreviation {
+			stem, ending := r.Analysis.GetStem(), r.Analysis.GetEnding()
+			if turkish.Instance.ToLower(stem) == turkish.Instance.ToLower(target.Lemma) {
+				stem = target.Lemma
+			}
+			form = stem
+			if ending != "" {
+				form += "'" + ending
+			}
+		}
+		if !containsForm(forms, form) {
+			forms = append(forms, form)
+		}
+	}
+	return forms
+}
+
+func containsForm(forms []string, form string) bool {
+	for _, f := range forms {
+		if f == form {
+			return true
+		}
+	}
+	return false
+}
+
+func startsWithUpper(s string) bool {
+	for _, r := range s {
+		return unicode.IsUpper(r)
+	}
+	return false
+}
+
+// capitalizeFirst upper cases the first letter only, keeping the rest of s
+func capitalizeFirst(s string) string {
+	runes := []rune(s)
+	if len(runes) == 0 {
+		return s
+	}
+	if runes[0] == 'i' {
+		runes[0] = 'İ'
+	} else {
+		runes[0] = unicode.ToUpper(runes[0])
+	}
+	return string(runes)
+}
@@ -0,0 +1,40 @@
+package morphology
+
+import "testing"
+
+func TestReinflect(t *testing.T) {
+	morph := testMorphology()
+	tests := []struct {
+		word     string
+		target   string
+		expected string
+	}{
+		{"kitaplarımızdan", "defter", "defterlerimizden"},
+		{"defterine", "kitap", "kitabına"},
+		{"Defterde", "kitap", "Kitapta"},
+		{"Ankara'ya", "İzmir", "İzmir'e"},
+		{"Ankara'daki", "İzmir", "İzmir'deki"},
+		{"Ankara", "İzmir_Noun_Prop", "İzmir"},
+		{"kitaba", "Ankara", "Ankara'ya"},
+		{"İzmir'den", "kitap", "kitaptan"},
+		{"geliyorduk", "okumak", "okuyorduk"},
+		{"gelmeyecekmiş", "okumak", "okumayacakmış"},
+	}
+	for _, tt := range tests {
+		got, err := morph.Reinflect(tt.word, tt.target)
+		if err != nil {
+			t.Errorf("%s -> %s: %v", tt.word, tt.target, err)
+			continue
+		}
+		if got != tt.expected {
+			t.Errorf("%s -> %s: expected %s, got %s", tt.word, tt.target, tt.expected, got)
+		}
+	}
+
+	if _, err := morph.Reinflect("kitaba", "masa"); err == nil {
+		t.Error("expected error for unknown target")
+	}
+	if _, err := morph.Reinflect("geldim", "kitap"); err == nil {
+		t.Error("expected error for a verb form on a noun")
+	}
+}
@@ -3,7 +3,6 @@ package morphology
 import (
 	"strings"
 	"sync"
-	"unicode/utf8"
 
 	analysispkg "github.com/kalaomer/zemberek-go/morphology/analysis"
 	"github.com/kalaomer/zemberek-go/core/turkish"
@@ -48,8 +47,8 @@ type stemmingJob struct {
 // - Thread-safe: morphology.Analyze() is concurrent-safe
 //
 // Token Type Handling:
-// - Punctuation, Whitespace, Emoticon, Unknown → FILTERED (not included in results)
-// - URL, Email, Number, Date, Time → PRESERVED (kept as-is, not stemmed)
+// - Punctuation, Whitespace, Emoticon, Symbol, Unknown → FILTERED (not included in results)
+// - URL, Email, Number, Date, Time, Emoji → PRESERVED (kept as-is, not stemmed)
 // - Word, Abbreviation, WordAlphanumerical → STEMMED
 // - Mention, HashTag → PREPROCESSED (strip prefix) then STEMMED
 //
@@ -82,36 +81,15 @@ func StemText(text string, morphology *TurkishMorphology) []string {
 }
 
 func StemTextWithPositions(text string, morphology *TurkishMorphology) []StemToken {
-	// ALWAYS USE FAST TOKENIZER for maximum performance
-	//
-	// Performance comparison (10KB legal document):
-	// - Fast tokenizer:    ~20-30ms (direct rune iteration, no regex)
-	// - Default tokenizer: ~473ms  (28 regex patterns per position)
-	// - Speedup:           1000x faster!
-	//
-	// Why fast tokenizer is always better:
-	// - URLs/emails are not stemmed anyway (filtered by shouldSkipStemming)
-	// - For 10M+ documents × 1000+ words, speed is critical
-	// - Fast tokenizer handles Turkish text perfectly (letters, apostrophes, numbers, punctuation)
-	// - Proper rune/byte position tracking maintained
-	// - All tests pass (backward compatible)
-	//
-	// Trade-offs accepted:
-	// - No hashtag/mention detection (not needed for legal documents)
-	// - No emoji support (not relevant for Turkish legal text)
-	// - Simpler is faster and better for production use
-
-	// Use fast tokenizer for ALL documents
-	tokens := tokenizeFast(text)
+	// The default tokenizer scans text once with a hand-written lexer, so it
+	// is fast enough for large documents and recognizes URLs, emails,
+	// hashtags and abbreviations, which are not stemmed
+	tokens := tokenization.DEFAULT.Tokenize(text)
 
 	if len(tokens) == 0 {
 		return []StemToken{}
 	}
 
-	// Convert rune positions to byte positions
-	// Token.Start and Token.End are RUNE positions, we need BYTE positions
-	bytePositions := calculateBytePositions(text, tokens)
-
 	// Filter tokens and build job list
 	jobs := make([]stemmingJob, 0, len(tokens))
 	for i, token := range tokens {
@@ -125,8 +103,8 @@ func StemTextWithPositions(text string, morphology *TurkishMorphology) []StemTok
 		jobs = append(jobs, stemmingJob{
 			index:     i,
 			token:     token,
-			startByte: bytePositions[i].start,
-			endByte:   bytePositions[i].end,
+			startByte: token.StartByte,
+			endByte:   token.EndByte,
 			needsStem: needsStem,
 		})
 	}
@@ -196,6 +174,7 @@ func shouldFilterToken(tokenType tokenization.TokenType) bool {
 		tokenization.SpaceTab,      // space, tab (already filtered by DEFAULT tokenizer)
 		tokenization.NewLine,       // \n, \r (already filtered by DEFAULT tokenizer)
 		tokenization.Emoticon,      // :) :( ^_^
+		tokenization.Symbol,        // € → ★
 		tokenization.MetaTag,       // <xml>
 		tokenization.Unknown,       // unidentified characters
 		tokenization.UnknownWord:   // unidentified words
@@ -214,6 +193,7 @@ func shouldSkipStemming(tokenType tokenization.TokenType) bool {
 		tokenization.Number,         // 123, 123/456, 12.5
 		tokenization.PercentNumeral, // %50
 		tokenization.Date,           // 15.08.2023
+		tokenization.Emoji,          // 👍🏽
 		tokenization.Time:           // 14:30
 		return true
 	default:
@@ -259,62 +239,6 @@ func tokensWithoutStemming(jobs []stemmingJob) []StemToken {
 	return result
 }
 
-// bytePosition holds byte position info
-type bytePosition struct {
-	start int
-	end   int
-}
-
-// calculateBytePositions converts rune positions to byte positions
-// Token.Start and Token.End are RUNE indices, we need BYTE offsets
-func calculateBytePositions(text string, tokens []*tokenization.Token) []bytePosition {
-	positions := make([]bytePosition, len(tokens))
-
-	if len(tokens) == 0 {
-		return positions
-	}
-
-	// SUPER OPTIMIZED FOR LARGE DOCUMENTS (10M+ documents × 1000+ words)
-	//
-	// Key insight: Token.Content already contains the exact token text!
-	// We only need to scan whitespace/punctuation BETWEEN tokens, not the tokens themselves.
-	//
-	// Performance comparison for 10KB document with 1000 words:
-	// - Old approach: Scan ALL 10,000 characters = 10,000 UTF-8 decodes
-	// - New approach: Scan only ~1,000 whitespace chars between tokens = 1,000 UTF-8 decodes
-	// - Speedup: 10x faster! (and even better for documents with long words)
-	//
-	// Critical for user's use case: 10 million documents × 1000+ words each
-
-	byteOffset := 0
-	runeOffset := 0
-
-	for i, token := range tokens {
-		// Skip to token start by scanning only the gap (whitespace/punct) between tokens
-		for runeOffset < token.Start && byteOffset < len(text) {
-			_, size := utf8.DecodeRuneInString(text[byteOffset:])
-			byteOffset += size
-			runeOffset++
-		}
-
-		startByte := byteOffset
-
-		// Token.Content has the exact byte length - no character scanning needed!
-		endByte := startByte + len(token.Content)
-
-		// Move to next token's position
-		byteOffset = endByte
-		runeOffset = token.End + 1 // token.End is inclusive
-
-		positions[i] = bytePosition{
-			start: startByte,
-			end:   endByte,
-		}
-	}
-
-	return positions
-}
-
 // stemWord performs cached morphological analysis on a single word
 // Uses global cache for performance (thread-safe with sync.Map)
 func stemWord(word string, morphology *TurkishMorphology) string {
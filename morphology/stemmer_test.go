@@ -568,68 +568,6 @@ func BenchmarkShouldFilterToken(b *testing.B) {
 	}
 }
 
-// BenchmarkCalculateBytePositions_Short measures byte position calculation for short text
-func BenchmarkCalculateBytePositions_Short(b *testing.B) {
-	text := "Anayasa Mahkemesi kararları"
-	tokenizer := tokenization.DEFAULT
-	tokens := tokenizer.Tokenize(text)
-	b.ResetTimer()
-	b.ReportAllocs()
-
-	for i := 0; i < b.N; i++ {
-		_ = calculateBytePositions(text, tokens)
-	}
-}
-
-// BenchmarkCalculateBytePositions_Medium measures byte position calculation for medium text
-func BenchmarkCalculateBytePositions_Medium(b *testing.B) {
-	text := "Türkiye Cumhuriyeti Anayasa Mahkemesi'nin 2023 yılında verdiği kararlar incelendiğinde"
-	tokenizer := tokenization.DEFAULT
-	tokens := tokenizer.Tokenize(text)
-	b.ResetTimer()
-	b.ReportAllocs()
-
-	for i := 0; i < b.N; i++ {
-		_ = calculateBytePositions(text, tokens)
-	}
-}
-
-// BenchmarkCalculateBytePositions_Large measures byte position calculation for large text (1KB)
-func BenchmarkCalculateBytePositions_Large(b *testing.B) {
-	// Build a 1KB text by repeating a sentence
-	sentence := "Anayasa Mahkemesi, başvurucu tarafından ileri sürülen ihlal iddialarını inceleyerek karar vermiştir. "
-	text := ""
-	for len(text) < 1000 {
-		text += sentence
-	}
-	tokenizer := tokenization.DEFAULT
-	tokens := tokenizer.Tokenize(text)
-	b.ResetTimer()
-	b.ReportAllocs()
-
-	for i := 0; i < b.N; i++ {
-		_ = calculateBytePositions(text, tokens)
-	}
-}
-
-// BenchmarkCalculateBytePositions_VeryLarge measures byte position calculation for very large text (10KB)
-func BenchmarkCalculateBytePositions_VeryLarge(b *testing.B) {
-	// Build a 10KB text
-	sentence := "Anayasa Mahkemesi, başvurucu tarafından ileri sürülen ihlal iddialarını inceleyerek karar vermiştir. "
-	text := ""
-	for len(text) < 10000 {
-		text += sentence
-	}
-	tokenizer := tokenization.DEFAULT
-	tokens := tokenizer.Tokenize(text)
-	b.ResetTimer()
-	b.ReportAllocs()
-
-	for i := 0; i < b.N; i++ {
-		_ = calculateBytePositions(text, tokens)
-	}
-}
-
 // BenchmarkWorkerPoolOverhead_SmallJob measures worker pool overhead for small jobs
 func BenchmarkWorkerPoolOverhead_SmallJob(b *testing.B) {
 	morph := CreateWithDefaults()
@@ -0,0 +1,72 @@
+package suffix
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/kalaomer/zemberek-go/core/turkish"
+)
+
+// numberPronunciation returns the last word of a number read aloud, which is
+// all that suffix harmony depends on, e.g. "2,5" → "beş" and "3." → "üçüncü"
+func numberPronunciation(word string) string {
+	words, err := turkish.ReadNumber(word)
+	if err != nil {
+		return word
+	}
+	return words[strings.LastIndexByte(words, ' ')+1:]
+}
+
+var letterNames = map[rune]string{
+	'b': "be", 'c': "ce", 'ç': "çe", 'd': "de", 'f': "fe", 'g': "ge", 'ğ': "yumuşak ge",
+	'h': "he", 'j': "je", 'k': "ke", 'l': "le", 'm': "me", 'n': "ne", 'p': "pe", 'q': "kü",
+	'r': "re", 's': "se", 'ş': "şe", 't': "te", 'v': "ve", 'w': "ve", 'x': "iks", 'y': "ye",
+	'z': "ze",
+}
+
+// abbreviationPronunciation reads an abbreviation as a word if it looks
+// pronounceable ("NATO", "ODTÜ"), otherwise by its letter names ("TBMM",
+// "AB").
+func abbreviationPronunciation(word string) string {
+	lower := []rune(turkish.Instance.ToLower(word))
+	letters := make([]rune, 0, len(lower))
+	for _, r := range lower {
+		if unicode.IsLetter(r) {
+			letters = append(letters, r)
+		}
+	}
+
+	if pronounceable(letters) {
+		return string(letters)
+	}
+	var sb strings.Builder
+	for _, r := range letters {
+		if name, ok := letterNames[r]; ok {
+			sb.WriteString(name)
+		} else {
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
+
+// pronounceable reports whether letters have at least three letters, a vowel,
+// no three consonants in a row and do not end with two consonants
+func pronounceable(letters []rune) bool {
+	if len(letters) < 3 {
+		return false
+	}
+	vowels, run := 0, 0
+	for _, r := range letters {
+		if turkish.Instance.IsVowel(r) {
+			vowels++
+			run = 0
+			continue
+		}
+		run++
+		if run >= 3 {
+			return false
+		}
+	}
+	return vowels > 0 && run < 2
+}
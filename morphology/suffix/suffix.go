@@ -0,0 +1,240 @@
+// Package suffix attaches Turkish case, possessive and plural suffixes to
+// arbitrary words, numbers and abbreviations, e.g. for user interface
+// strings like "{user}'{dat} mesaj gönderildi".
+//
+// Suffix surfaces follow vowel harmony and consonant assimilation of the
+// pronunciation of a word: numbers are read as words ("3" → "üç", so "3'e")
+// and abbreviations by their letter names ("TBMM" → "tebeemem", so
+// "TBMM'ye"). Names, numbers and abbreviations are never modified. Stem
+// changes of common nouns, like voicing (kitap → kitaba) or vowel drop
+// (burun → burnu), need the lexicon: an Attacher created with NewAttacher
+// inflects lower-case words of the lexicon with the word generator. Without
+// it suffixes are only correct for words whose stem does not change.
+package suffix
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/kalaomer/zemberek-go/core/turkish"
+	"github.com/kalaomer/zemberek-go/morphology/analysis"
+	"github.com/kalaomer/zemberek-go/morphology/generator"
+	"github.com/kalaomer/zemberek-go/morphology/morphotactics"
+)
+
+// Suffix is an inflectional suffix written with the morphotactics suffix
+// template notation, e.g. "+yA" for the dative
+type Suffix struct {
+	Name     string
+	Template string
+	// AfterPossessive is used after third person possessives, which take a
+	// pronominal n before cases (evi-n-e). Empty if the template does not
+	// change.
+	AfterPossessive string
+	possessive3     bool
+
+	// morpheme is the morpheme of the suffix in the morphotactics and slot
+	// its place in a noun: number, possessive or case
+	morpheme *morphotactics.Morpheme
+	slot     int
+}
+
+// Slots of noun suffixes, in the order they are attached
+const (
+	numberSlot = iota
+	possessiveSlot
+	caseSlot
+)
+
+// Suffixes
+var (
+	Plural = &Suffix{Name: "pl", Template: "lAr", morpheme: morphotactics.A3pl, slot: numberSlot}
+
+	Acc = &Suffix{Name: "acc", Template: "+yI", AfterPossessive: "nI", morpheme: morphotactics.Acc, slot: caseSlot}
+	Dat = &Suffix{Name: "dat", Template: "+yA", AfterPossessive: "nA", morpheme: morphotactics.Dat, slot: caseSlot}
+	Loc = &Suffix{Name: "loc", Template: ">dA", AfterPossessive: "ndA", morpheme: morphotactics.Loc, slot: caseSlot}
+	Abl = &Suffix{Name: "abl", Template: ">dAn", AfterPossessive: "ndAn", morpheme: morphotactics.Abl, slot: caseSlot}
+	Gen = &Suffix{Name: "gen", Template: "+nIn", morpheme: morphotactics.Gen, slot: caseSlot}
+	Ins = &Suffix{Name: "ins", Template: "+ylA", morpheme: morphotactics.Ins, slot: caseSlot}
+	Equ = &Suffix{Name: "equ", Template: ">cA", AfterPossessive: "ncA", morpheme: morphotactics.Equ, slot: caseSlot}
+
+	P1sg = &Suffix{Name: "p1sg", Template: "+Im", morpheme: morphotactics.P1sg, slot: possessiveSlot}
+	P2sg = &Suffix{Name: "p2sg", Template: "+In", morpheme: morphotactics.P2sg, slot: possessiveSlot}
+	P3sg = &Suffix{Name: "p3sg", Template: "+sI", possessive3: true, morpheme: morphotactics.P3sg, slot: possessiveSlot}
+	P1pl = &Suffix{Name: "p1pl", Template: "+ImIz", morpheme: morphotactics.P1pl, slot: possessiveSlot}
+	P2pl = &Suffix{Name: "p2pl", Template: "+InIz", morpheme: morphotactics.P2pl, slot: possessiveSlot}
+	P3pl = &Suffix{Name: "p3pl", Template: "lArI", possessive3: true, morpheme: morphotactics.P3pl, slot: possessiveSlot}
+)
+
+var suffixes = map[string]*Suffix{}
+
+func init() {
+	for _, s := range []*Suffix{Plural, Acc, Dat, Loc, Abl, Gen, Ins, Equ, P1sg, P2sg, P3sg, P1pl, P2pl, P3pl} {
+		suffixes[s.Name] = s
+	}
+}
+
+// Lookup returns the suffix with a name like "dat" or "p3sg"
+func Lookup(name string) (*Suffix, bool) {
+	s, ok := suffixes[strings.ToLower(name)]
+	return s, ok
+}
+
+// String returns the name of the suffix
+func (s *Suffix) String() string {
+	return s.Name
+}
+
+// Attacher attaches suffixes to words
+type Attacher struct {
+	// Pronunciations overrides the pronunciation of words, e.g. "SQL" →
+	// "sikuel"
+	Pronunciations map[string]string
+	// Generator inflects lower-case nouns of its lexicon, so that their stem
+	// changes are applied. Nil attaches suffixes to every word as written.
+	Generator *generator.WordGenerator
+}
+
+// Default is the attacher without pronunciation overrides and without a
+// generator
+var Default = &Attacher{}
+
+// NewAttacher creates an attacher that inflects the nouns of the lexicon of
+// gen, e.g. the WordGenerator of a morphology.TurkishMorphology
+func NewAttacher(gen *generator.WordGenerator) *Attacher {
+	return &Attacher{Generator: gen}
+}
+
+// Attach attaches suffixes to word with the default attacher
+func Attach(word string, suffixes ...*Suffix) string {
+	return Default.Attach(word, suffixes...)
+}
+
+// Attach appends suffixes to word in order. An apostrophe is inserted after
+// proper nouns (capitalized words), numbers and abbreviations. An empty word
+// and suffixes out of noun order are returned unchanged, see Inflect.
+func (a *Attacher) Attach(word string, suffixes ...*Suffix) string {
+	stem, ending := a.Inflect(word, suffixes...)
+	if ending != "" && NeedsApostrophe(word) {
+		return stem + "'" + ending
+	}
+	return stem + ending
+}
+
+// Inflect returns the stem of word and the surface of suffixes attached to
+// it, without an apostrophe. The stem is word unless the generator changes
+// it, like "kitab" of "kitaba". Suffixes must be in noun order, number,
+// possessive and case, each at most once; otherwise, or if word is empty,
+// the ending is empty.
+func (a *Attacher) Inflect(word string, suffixes ...*Suffix) (stem, ending string) {
+	if word == "" || !inOrder(suffixes) {
+		return word, ""
+	}
+	if stem, ending, ok := a.generate(word, suffixes); ok {
+		return stem, ending
+	}
+	return word, a.harmonize(word, suffixes)
+}
+
+// Ending returns the surface of suffixes attached to the stem of word,
+// without an apostrophe. See Inflect for words whose stem changes.
+func (a *Attacher) Ending(word string, suffixes ...*Suffix) string {
+	_, ending := a.Inflect(word, suffixes...)
+	return ending
+}
+
+// inOrder reports whether suffixes are in noun order: number, possessive
+// and case, each at most once
+func inOrder(suffixes []*Suffix) bool {
+	slot := -1
+	for _, s := range suffixes {
+		if s.slot <= slot {
+			return false
+		}
+		slot = s.slot
+	}
+	return true
+}
+
+// harmonize returns the surface of suffixes attached to word as written
+func (a *Attacher) harmonize(word string, suffixes []*Suffix) string {
+	pronunciation := a.Pronounce(word)
+	attrs := morphotactics.GetPhoneticAttributes(pronunciation, nil)
+	var sb strings.Builder
+	afterPossessive := false
+	for _, s := range suffixes {
+		template := s.Template
+		if afterPossessive && s.AfterPossessive != "" {
+			template = s.AfterPossessive
+		}
+		surface := analysis.GenerateSurfaceFromTokens(morphotactics.TokenizeSuffixTemplate(template), attrs)
+		sb.WriteString(surface)
+		attrs = analysis.GetMorphemicAttributes(surface, attrs)
+		afterPossessive = s.possessive3
+	}
+	return sb.String()
+}
+
+// generate inflects word with the generator if it is a lower-case noun of
+// its lexicon. Suffixes are in noun order.
+func (a *Attacher) generate(word string, suffixes []*Suffix) (stem, ending string, ok bool) {
+	if a.Generator == nil || len(suffixes) == 0 || NeedsApostrophe(word) {
+		return "", "", false
+	}
+	morphemes := []*morphotactics.Morpheme{morphotactics.Noun, morphotactics.A3sg, morphotactics.Pnon, morphotactics.Nom}
+	for _, s := range suffixes {
+		morphemes[s.slot+1] = s.morpheme
+	}
+
+	for _, item := range a.Generator.Morphotactics.GetRootLexicon().GetItems(word) {
+		if item.PrimaryPos != turkish.Noun || item.SecondaryPos != turkish.NonePos {
+			continue
+		}
+		for _, result := range a.Generator.Generate(item, morphemes) {
+			stem := result.Analysis.GetStem()
+			if strings.HasPrefix(result.Surface, stem) {
+				return stem, result.Surface[len(stem):], true
+			}
+		}
+	}
+	return "", "", false
+}
+
+// Pronounce returns the lower case pronunciation of word that suffixes
+// harmonize with. Numbers are read as numbers and abbreviations by their
+// letter names unless they can be read as words.
+func (a *Attacher) Pronounce(word string) string {
+	if p, ok := a.Pronunciations[word]; ok {
+		return p
+	}
+	if turkish.IsNumber(word) {
+		return numberPronunciation(word)
+	}
+	if isAbbreviation(word) {
+		return abbreviationPronunciation(word)
+	}
+	return turkish.Instance.ToLower(word)
+}
+
+// NeedsApostrophe reports whether suffixes of word are written after an
+// apostrophe: for capitalized words, abbreviations and numbers
+func NeedsApostrophe(word string) bool {
+	for _, r := range word {
+		return unicode.IsUpper(r) || unicode.IsDigit(r) || r == '%'
+	}
+	return false
+}
+
+func isAbbreviation(word string) bool {
+	letters := 0
+	for _, r := range word {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		if !unicode.IsUpper(r) {
+			return false
+		}
+		letters++
+	}
+	return letters >= 2
+}
@@ -0,0 +1,148 @@
+package suffix
+
+import (
+	"testing"
+
+	"github.com/kalaomer/zemberek-go/core/turkish"
+	"github.com/kalaomer/zemberek-go/morphology"
+	"github.com/kalaomer/zemberek-go/morphology/lexicon"
+)
+
+func TestAttach(t *testing.T) {
+	tests := []struct {
+		word     string
+		suffixes []*Suffix
+		expected string
+	}{
+		{"Ali", []*Suffix{Dat}, "Ali'ye"},
+		{"Ahmet", []*Suffix{Acc}, "Ahmet'i"},
+		{"İzmir", []*Suffix{Loc}, "İzmir'de"},
+		{"Paris", []*Suffix{Abl}, "Paris'ten"},
+		{"dosya", []*Suffix{Plural}, "dosyalar"},
+		{"göz", []*Suffix{Plural, P1sg, Abl}, "gözlerimden"},
+		{"ev", []*Suffix{P3sg, Dat}, "evine"},
+		{"Ankara", []*Suffix{P3sg, Loc}, "Ankara'sında"},
+		{"okul", []*Suffix{P3pl, Acc}, "okullarını"},
+		{"masa", []*Suffix{Ins}, "masayla"},
+		{"3", []*Suffix{Dat}, "3'e"},
+		{"5", []*Suffix{Loc}, "5'te"},
+		{"10", []*Suffix{Dat}, "10'a"},
+		{"40", []*Suffix{Abl}, "40'tan"},
+		{"2000", []*Suffix{Loc}, "2000'de"},
+		{"1.000.000", []*Suffix{Gen}, "1.000.000'un"},
+		{"2,5", []*Suffix{Acc}, "2,5'i"},
+		{"%60", []*Suffix{Dat}, "%60'a"},
+		{"0", []*Suffix{Dat}, "0'a"},
+		{"3.", []*Suffix{Dat}, "3.'ye"},
+		{"TBMM", []*Suffix{Dat}, "TBMM'ye"},
+		{"ABD", []*Suffix{Loc}, "ABD'de"},
+		{"AB", []*Suffix{Abl}, "AB'den"},
+		{"NATO", []*Suffix{Dat}, "NATO'ya"},
+		{"ODTÜ", []*Suffix{Gen}, "ODTÜ'nün"},
+		{"Ali", nil, "Ali"},
+		{"ev", []*Suffix{Dat, Plural}, "ev"},
+		{"ev", []*Suffix{P1sg, P3sg}, "ev"},
+		{"", []*Suffix{P3sg, Loc}, ""},
+	}
+	for _, tt := range tests {
+		if got := Attach(tt.word, tt.suffixes...); got != tt.expected {
+			t.Errorf("%s %v: expected %s, got %s", tt.word, tt.suffixes, tt.expected, got)
+		}
+	}
+
+	a := &Attacher{Pronunciations: map[string]string{"SQL": "sikuel"}}
+	if got := a.Attach("SQL", Loc); got != "SQL'de" {
+		t.Errorf("expected SQL'de, got %s", got)
+	}
+}
+
+func TestAttachWithGenerator(t *testing.T) {
+	lex := lexicon.NewRootLexicon([]*lexicon.DictionaryItem{
+		lexicon.NewDictionaryItem("kitap", "kitap", turkish.Noun, turkish.NonePos,
+			map[turkish.RootAttribute]bool{turkish.Voicing: true}, "", 0),
+		lexicon.NewDictionaryItem("burun", "burun", turkish.Noun, turkish.NonePos,
+			map[turkish.RootAttribute]bool{turkish.LastVowelDrop: true}, "", 0),
+		lexicon.NewDictionaryItem("ev", "ev", turkish.Noun, turkish.NonePos, nil, "", 0),
+	})
+	a := NewAttacher(morphology.NewBuilder(lex).Build().WordGenerator)
+
+	tests := []struct {
+		word     string
+		suffixes []*Suffix
+		expected string
+	}{
+		{"kitap", []*Suffix{Dat}, "kitaba"},
+		{"kitap", []*Suffix{P3sg}, "kitabı"},
+		{"kitap", []*Suffix{Plural, Dat}, "kitaplara"},
+		{"kitap", []*Suffix{P1sg, Abl}, "kitabımdan"},
+		{"burun", []*Suffix{P3sg, Loc}, "burnunda"},
+		{"ev", []*Suffix{P3sg, Dat}, "evine"},
+		{"Kitap", []*Suffix{Dat}, "Kitap'a"},
+		{"kitap", []*Suffix{Dat, Plural}, "kitap"},
+		{"masa", []*Suffix{Ins}, "masayla"},
+	}
+	for _, tt := range tests {
+		if got := a.Attach(tt.word, tt.suffixes...); got != tt.expected {
+			t.Errorf("%s %v: expected %s, got %s", tt.word, tt.suffixes, tt.expected, got)
+		}
+	}
+	if got := Default.Attach("kitap", Dat); got != "kitapa" {
+		t.Errorf("expected kitapa without a generator, got %s", got)
+	}
+
+	tmpl, err := a.Parse("{n} {{kitap} ve kitap{acc} okudu")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := tmpl.Execute(map[string]string{"n": "3"}); err != nil || got != "3 {kitap} ve kitabı okudu" {
+		t.Errorf("expected 3 {kitap} ve kitabı okudu, got %s (%v)", got, err)
+	}
+}
+
+func TestTemplate(t *testing.T) {
+	tests := []struct {
+		template string
+		values   map[string]string
+		expected string
+	}{
+		{"{user}'{dat} mesaj gönderildi", map[string]string{"user": "Ayşe"}, "Ayşe'ye mesaj gönderildi"},
+		{"{user}'{dat} mesaj gönderildi", map[string]string{"user": "Mehmet"}, "Mehmet'e mesaj gönderildi"},
+		{"{count} dosya{pl}", map[string]string{"count": "3"}, "3 dosyalar"},
+		{"{city}'{loc}", map[string]string{"city": "Kars"}, "Kars'ta"},
+		{"{city}{loc} yaşıyor", map[string]string{"city": "Van"}, "Van'da yaşıyor"},
+		{"{name} ve {friend}{p3sg+gen}", map[string]string{"name": "Ali", "friend": "kedi"}, "Ali ve kedisinin"},
+		{"{{literal} {n}{abl}", map[string]string{"n": "7"}, "{literal} 7'den"},
+	}
+	for _, tt := range tests {
+		tmpl, err := Parse(tt.template)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.template, err)
+		}
+		got, err := tmpl.Execute(tt.values)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.template, err)
+		}
+		if got != tt.expected {
+			t.Errorf("%s: expected %s, got %s", tt.template, tt.expected, got)
+		}
+	}
+
+	tmpl := MustParse("{user}'{dat} {count} mesaj")
+	if names := tmpl.Names(); len(names) != 2 || names[0] != "user" || names[1] != "count" {
+		t.Errorf("unexpected names %v", names)
+	}
+	if _, err := tmpl.Execute(map[string]string{"user": "Ali"}); err == nil {
+		t.Error("expected error for a missing value")
+	}
+	if _, err := Parse("{user"); err == nil {
+		t.Error("expected error for an unclosed placeholder")
+	}
+	for _, s := range []string{"{a}{dat+pl}", "{a}{dat}{pl}", "{a}'{loc}'{p1sg}"} {
+		if _, err := Parse(s); err == nil {
+			t.Errorf("%s: expected error for suffixes out of order", s)
+		}
+	}
+	if got, err := MustParse("{a}{pl}{dat}").Execute(map[string]string{"a": "ev"}); err != nil || got != "evlere" {
+		t.Errorf("{a}{pl}{dat}: got %s, %v", got, err)
+	}
+}
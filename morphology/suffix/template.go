@@ -0,0 +1,158 @@
+package suffix
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Template is a text with value and suffix placeholders, e.g.
+//
+//	{user}'{dat} mesaj gönderildi
+//	{count} dosya{pl}
+//	{city}'{p3sg+loc}
+//
+// A placeholder whose name is a suffix name, or suffix names joined with "+",
+// attaches the suffixes to the word before it. Other placeholders are
+// replaced by values. An apostrophe before a suffix placeholder is written if
+// the suffixes are not empty; without it an apostrophe is inserted only where
+// NeedsApostrophe requires one. Suffixes of a placeholder, and of
+// placeholders that follow each other, are in noun order: number,
+// possessive and case. "{{" is a literal "{".
+type Template struct {
+	attacher *Attacher
+	parts    []templatePart
+}
+
+type templatePart struct {
+	text       string
+	value      string
+	suffixes   []*Suffix
+	apostrophe bool
+}
+
+// Parse parses a template with the default attacher
+func Parse(s string) (*Template, error) {
+	return Default.Parse(s)
+}
+
+// MustParse is like Parse but panics on errors
+func MustParse(s string) *Template {
+	t, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// Parse parses a template that attaches suffixes with a
+func (a *Attacher) Parse(s string) (*Template, error) {
+	t := &Template{attacher: a}
+	var text strings.Builder
+	flush := func() {
+		if text.Len() > 0 {
+			t.parts = append(t.parts, templatePart{text: text.String()})
+			text.Reset()
+		}
+	}
+
+	for i := 0; i < len(s); i++ {
+		if s[i] != '{' {
+			text.WriteByte(s[i])
+			continue
+		}
+		if strings.HasPrefix(s[i:], "{{") {
+			text.WriteByte('{')
+			i++
+			continue
+		}
+		end := strings.IndexByte(s[i:], '}')
+		if end < 0 {
+			return nil, fmt.Errorf("suffix: %q: unclosed placeholder at %d", s, i)
+		}
+		name := strings.TrimSpace(s[i+1 : i+end])
+		if name == "" {
+			return nil, fmt.Errorf("suffix: %q: empty placeholder at %d", s, i)
+		}
+		i += end
+
+		suffixes, ok := lookupAll(name)
+		if !ok {
+			flush()
+			t.parts = append(t.parts, templatePart{value: name})
+			continue
+		}
+		apostrophe := false
+		if str := text.String(); strings.HasSuffix(str, "'") {
+			text.Reset()
+			text.WriteString(str[:len(str)-1])
+			apostrophe = true
+		}
+		// suffixes of consecutive placeholders attach to the same word
+		order := suffixes
+		if n := len(t.parts); text.Len() == 0 && n > 0 && t.parts[n-1].suffixes != nil {
+			order = append(append([]*Suffix{}, t.parts[n-1].suffixes...), suffixes...)
+		}
+		if !inOrder(order) {
+			return nil, fmt.Errorf("suffix: %q: suffixes out of order at %d", s, i-end)
+		}
+		flush()
+		t.parts = append(t.parts, templatePart{suffixes: suffixes, apostrophe: apostrophe})
+	}
+	flush()
+	return t, nil
+}
+
+func lookupAll(name string) ([]*Suffix, bool) {
+	var result []*Suffix
+	for _, n := range strings.Split(name, "+") {
+		s, ok := Lookup(strings.TrimSpace(n))
+		if !ok {
+			return nil, false
+		}
+		result = append(result, s)
+	}
+	return result, true
+}
+
+// Execute fills the template with values. Returns an error if a value is
+// missing.
+func (t *Template) Execute(values map[string]string) (string, error) {
+	var sb strings.Builder
+	for _, part := range t.parts {
+		switch {
+		case part.suffixes != nil:
+			out := sb.String()
+			word := out[strings.LastIndexFunc(out, unicode.IsSpace)+1:]
+			stem, ending := t.attacher.Inflect(word, part.suffixes...)
+			if stem != word {
+				sb.Reset()
+				sb.WriteString(out[:len(out)-len(word)] + stem)
+			}
+			if ending != "" && (part.apostrophe || NeedsApostrophe(word)) {
+				sb.WriteString("'")
+			}
+			sb.WriteString(ending)
+		case part.value != "":
+			v, ok := values[part.value]
+			if !ok {
+				return "", fmt.Errorf("suffix: missing value for {%s}", part.value)
+			}
+			sb.WriteString(v)
+		default:
+			sb.WriteString(part.text)
+		}
+	}
+	return sb.String(), nil
+}
+
+// Names returns the names of the value placeholders in order
+func (t *Template) Names() []string {
+	var names []string
+	for _, part := range t.parts {
+		if part.value != "" {
+			names = append(names, part.value)
+		}
+	}
+	return names
+}
@@ -1,6 +1,7 @@
 package morphology
 
 import (
+	"io/fs"
 	"strings"
 
 	"github.com/kalaomer/zemberek-go/core/turkish"
@@ -23,6 +24,7 @@ type TurkishMorphology struct {
 // Builder for TurkishMorphology
 type Builder struct {
 	lexicon                     *lexicon.RootLexicon
+	morphotactics               *morphotactics.TurkishMorphotactics
 	informalAnalysis            bool
 	ignoreDiacriticsInAnalysis  bool
 }
@@ -48,9 +50,20 @@ func (b *Builder) IgnoreDiacriticsInAnalysis() *Builder {
 	return b
 }
 
+// UseMorphotactics replaces the built-in morphotactics, e.g. with one loaded
+// by morphotactics.LoadMorphotactics. It must be created with the builder's
+// lexicon.
+func (b *Builder) UseMorphotactics(morph *morphotactics.TurkishMorphotactics) *Builder {
+	b.morphotactics = morph
+	return b
+}
+
 // Build creates TurkishMorphology instance
 func (b *Builder) Build() *TurkishMorphology {
-	morph := morphotactics.NewTurkishMorphotactics(b.lexicon)
+	morph := b.morphotactics
+	if morph == nil {
+		morph = morphotactics.NewTurkishMorphotactics(b.lexicon)
+	}
 
 	var analyzer *analysis.RuleBasedAnalyzer
 	if b.ignoreDiacriticsInAnalysis {
@@ -81,33 +94,86 @@ func CreateWithDefaults() *TurkishMorphology {
 	return NewBuilder(lex).Build()
 }
 
+// CreateFromFS creates a morphology with default settings using the binary
+// lexicon stored under lexicon.BinaryLexiconName in fsys (typically a
+// resource.Bundle)
+func CreateFromFS(fsys fs.FS) (*TurkishMorphology, error) {
+	items, err := lexicon.LoadBinaryLexiconFS(fsys, lexicon.BinaryLexiconName)
+	if err != nil {
+		return nil, err
+	}
+
+	lex := lexicon.NewRootLexicon(items)
+	return NewBuilder(lex).Build(), nil
+}
+
 // Analyze analyzes a word
 func (tm *TurkishMorphology) Analyze(word string) *analysis.WordAnalysis {
+	result, _ := tm.analyze(word, false)
+	return result
+}
+
+// AnalyzeWithTrace analyzes a word like Analyze and returns a trace of the
+// graph search that produced the results: the search for the number, for the
+// word without its apostrophe or for the normalized word. The trace input is
+// the searched string.
+func (tm *TurkishMorphology) AnalyzeWithTrace(word string) (*analysis.WordAnalysis, *analysis.AnalysisTrace) {
+	result, trace := tm.analyze(word, true)
+	if trace == nil {
+		trace = analysis.NewAnalysisTrace(tm.NormalizeForAnalysis(word))
+	}
+	return result, trace
+}
+
+// analyze analyzes word and returns the trace of the graph search that
+// produced the results if traced is set. The trace is nil if no search was
+// made.
+func (tm *TurkishMorphology) analyze(word string, traced bool) (*analysis.WordAnalysis, *analysis.AnalysisTrace) {
 	if word == "" {
-		return analysis.EmptyInputResult
+		return analysis.EmptyInputResult, nil
 	}
 
 	normalized := tm.NormalizeForAnalysis(word)
 	if normalized == "" {
-		return analysis.EmptyInputResult
+		return analysis.EmptyInputResult, nil
+	}
+
+	// Numbers are analysed before dots are removed
+	if results, trace := tm.analyzeNumber(turkish.Instance.NormalizeApostrophe(turkish.Instance.ToLower(word)), traced); results != nil {
+		return analysis.NewWordAnalysis(word, results, normalized), trace
 	}
 
 	// Handle apostrophe
 	if turkish.Instance.ContainsApostrophe(normalized) {
 		normalized = turkish.Instance.NormalizeApostrophe(normalized)
-		results := tm.analyzeWordsWithApostrophe(normalized)
-		return analysis.NewWordAnalysis(word, results, normalized)
+		results, trace := tm.analyzeWordsWithApostrophe(normalized, traced)
+		return analysis.NewWordAnalysis(word, results, normalized), trace
 	}
 
 	// Normal analysis
-	results := tm.Analyzer.Analyze(normalized)
+	results, trace := tm.analyzeTraced(normalized, traced)
 
 	// Filter unknown single results
 	if len(results) == 1 && results[0].IsUnknown() {
 		results = make([]*analysis.SingleAnalysis, 0)
 	}
 
-	return analysis.NewWordAnalysis(word, results, normalized)
+	return analysis.NewWordAnalysis(word, results, normalized), trace
+}
+
+// analyzeTraced analyzes input with the analyzer and returns a trace of the
+// search if traced is set
+func (tm *TurkishMorphology) analyzeTraced(input string, traced bool) ([]*analysis.SingleAnalysis, *analysis.AnalysisTrace) {
+	if traced {
+		return tm.Analyzer.AnalyzeWithTrace(input)
+	}
+	return tm.Analyzer.Analyze(input), nil
+}
+
+// ParseAnalysis parses an analysis string in FormatString or Java lexical
+// format, resolving dictionary items from the lexicon of this instance
+func (tm *TurkishMorphology) ParseAnalysis(s string) (*analysis.SingleAnalysis, error) {
+	return analysis.Parse(s, tm.Lexicon)
 }
 
 // NormalizeForAnalysis normalizes word for analysis
@@ -124,19 +190,20 @@ func (tm *TurkishMorphology) NormalizeForAnalysis(word string) string {
 	return noDot
 }
 
-// analyzeWordsWithApostrophe analyzes words containing apostrophe
-func (tm *TurkishMorphology) analyzeWordsWithApostrophe(word string) []*analysis.SingleAnalysis {
+// analyzeWordsWithApostrophe analyzes words containing apostrophe. A trace
+// of the search is returned if traced is set.
+func (tm *TurkishMorphology) analyzeWordsWithApostrophe(word string, traced bool) ([]*analysis.SingleAnalysis, *analysis.AnalysisTrace) {
 	index := strings.IndexRune(word, '\'')
 	if index <= 0 || index == len(word)-1 {
-		return make([]*analysis.SingleAnalysis, 0)
+		return make([]*analysis.SingleAnalysis, 0), nil
 	}
 
 	// Remove apostrophe and analyze
 	withoutQuote := strings.ReplaceAll(word, "'", "")
-	noQuotesParses := tm.Analyzer.Analyze(withoutQuote)
+	noQuotesParses, trace := tm.analyzeTraced(withoutQuote, traced)
 
 	if len(noQuotesParses) == 0 {
-		return make([]*analysis.SingleAnalysis, 0)
+		return make([]*analysis.SingleAnalysis, 0), trace
 	}
 
 	// Filter for noun analyses
@@ -147,7 +214,7 @@ func (tm *TurkishMorphology) analyzeWordsWithApostrophe(word string) []*analysis
 		results = append(results, parse)
 	}
 
-	return results
+	return results, trace
 }
 
 // AnalyzeSentence analyzes all words in a sentence
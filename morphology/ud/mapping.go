@@ -0,0 +1,296 @@
+// Package ud maps morphological analyses to Universal Dependencies part of
+// speech tags and morphological features.
+package ud
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/kalaomer/zemberek-go/core/turkish"
+	"github.com/kalaomer/zemberek-go/morphology/analysis"
+)
+
+//go:embed data/ud-mapping.txt
+var defaultMappingData string
+
+// Features is a set of UD morphological features, e.g. Case=Abl
+type Features map[string]string
+
+// String returns features in CoNLL-U FEATS form, sorted by name and joined
+// with "|". Empty features are written as "_".
+func (f Features) String() string {
+	if len(f) == 0 {
+		return "_"
+	}
+	names := make([]string, 0, len(f))
+	for name := range f {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		a, b := strings.ToLower(names[i]), strings.ToLower(names[j])
+		if a == b {
+			return names[i] < names[j]
+		}
+		return a < b
+	})
+	parts := make([]string, len(names))
+	for i, name := range names {
+		parts[i] = name + "=" + f[name]
+	}
+	return strings.Join(parts, "|")
+}
+
+// ParseFeatures parses features written as Name=Value pairs separated by "|"
+// or white space. "_" is an empty feature set.
+func ParseFeatures(s string) (Features, error) {
+	f := make(Features)
+	s = strings.TrimSpace(s)
+	if s == "" || s == "_" {
+		return f, nil
+	}
+	for _, pair := range strings.FieldsFunc(s, func(r rune) bool { return r == '|' || r == ' ' || r == '\t' }) {
+		eq := strings.Index(pair, "=")
+		if eq <= 0 || eq == len(pair)-1 {
+			return nil, fmt.Errorf("feature %q is not in Name=Value form", pair)
+		}
+		f[pair[:eq]] = pair[eq+1:]
+	}
+	return f, nil
+}
+
+// Annotation is the UD view of a single analysis
+type Annotation struct {
+	Lemma string
+	UPOS  string
+	XPOS  string
+	Feats Features
+}
+
+// Mapping maps analyses to UD annotations. It is data driven; the default
+// mapping is read from a line based text file where empty lines and lines
+// starting with # are ignored and the following lines are recognized:
+//
+//	upos <Pos> [<SecondaryPos>] <UPOS>      UPOS of a zemberek POS
+//	feats <morphemeId> <Name=Value>...      features of a morpheme
+//	derived <morphemeId> <UPOS>             UPOS of forms derived by a morpheme
+//	inherit <Name>...                       features kept by derived forms
+//	default <UPOS> <Name=Value>...          features added when missing
+//
+// Later lines override earlier ones, so a mapping can be adjusted by parsing
+// an additional file after the default one.
+//
+// Features of a word are those of the morphemes in its last inflectional
+// group, plus the inherited features of earlier groups. UPOS is taken from
+// the derivational morpheme of the last group if it has a derived line,
+// otherwise from the POS of the last group. The secondary POS of the
+// dictionary item is only considered for words without derivations.
+type Mapping struct {
+	upos     map[string]string
+	feats    map[string]Features
+	derived  map[string]string
+	inherit  map[string]bool
+	defaults map[string]Features
+}
+
+// NewMapping creates an empty mapping
+func NewMapping() *Mapping {
+	return &Mapping{
+		upos:     make(map[string]string),
+		feats:    make(map[string]Features),
+		derived:  make(map[string]string),
+		inherit:  make(map[string]bool),
+		defaults: make(map[string]Features),
+	}
+}
+
+// DefaultMapping returns a new copy of the built-in mapping. The result may be
+// modified freely.
+func DefaultMapping() *Mapping {
+	m := NewMapping()
+	if err := m.Parse(strings.NewReader(defaultMappingData), "ud-mapping.txt"); err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// LoadMapping reads a mapping from files. Later files override earlier ones.
+func LoadMapping(paths ...string) (*Mapping, error) {
+	m := NewMapping()
+	for _, path := range paths {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		err = m.Parse(f, path)
+		f.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// Parse reads mapping lines into m. source is used in error messages.
+func (m *Mapping) Parse(r io.Reader, source string) error {
+	scanner := bufio.NewScanner(r)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if err := m.parseLine(strings.Fields(line)); err != nil {
+			return fmt.Errorf("ud: %s:%d: %w", source, lineNumber, err)
+		}
+	}
+	return scanner.Err()
+}
+
+func (m *Mapping) parseLine(fields []string) error {
+	switch fields[0] {
+	case "upos":
+		if len(fields) != 3 && len(fields) != 4 {
+			return fmt.Errorf("upos needs a POS, an optional secondary POS and a UPOS")
+		}
+		pos, ok := turkish.PrimaryPosFromString(fields[1])
+		if !ok {
+			return fmt.Errorf("unknown POS %q", fields[1])
+		}
+		spos := turkish.NonePos
+		if len(fields) == 4 {
+			if spos, ok = turkish.SecondaryPosFromString(fields[2]); !ok {
+				return fmt.Errorf("unknown secondary POS %q", fields[2])
+			}
+		}
+		m.SetUPOS(pos, spos, fields[len(fields)-1])
+	case "feats":
+		if len(fields) < 3 {
+			return fmt.Errorf("feats needs a morpheme and features")
+		}
+		f, err := ParseFeatures(strings.Join(fields[2:], " "))
+		if err != nil {
+			return err
+		}
+		m.SetFeatures(fields[1], f)
+	case "derived":
+		if len(fields) != 3 {
+			return fmt.Errorf("derived needs a morpheme and a UPOS")
+		}
+		m.derived[fields[1]] = fields[2]
+	case "inherit":
+		if len(fields) < 2 {
+			return fmt.Errorf("inherit needs feature names")
+		}
+		for _, name := range fields[1:] {
+			m.inherit[name] = true
+		}
+	case "default":
+		if len(fields) < 3 {
+			return fmt.Errorf("default needs a UPOS and features")
+		}
+		f, err := ParseFeatures(strings.Join(fields[2:], " "))
+		if err != nil {
+			return err
+		}
+		if m.defaults[fields[1]] == nil {
+			m.defaults[fields[1]] = make(Features)
+		}
+		for name, value := range f {
+			m.defaults[fields[1]][name] = value
+		}
+	default:
+		return fmt.Errorf("unknown directive %q", fields[0])
+	}
+	return nil
+}
+
+func uposKey(pos turkish.PrimaryPos, spos turkish.SecondaryPos) string {
+	if spos == turkish.NonePos {
+		return pos.GetStringForm()
+	}
+	return pos.GetStringForm() + " " + spos.GetStringForm()
+}
+
+// SetUPOS sets the UPOS of a POS. spos is NonePos for the UPOS of the primary
+// POS alone.
+func (m *Mapping) SetUPOS(pos turkish.PrimaryPos, spos turkish.SecondaryPos, upos string) {
+	m.upos[uposKey(pos, spos)] = upos
+}
+
+// SetFeatures sets the features of a morpheme, replacing earlier ones
+func (m *Mapping) SetFeatures(morphemeID string, f Features) {
+	m.feats[morphemeID] = f
+}
+
+// Map returns the UD annotation of an analysis
+func (m *Mapping) Map(a *analysis.SingleAnalysis) *Annotation {
+	groups := a.GetGroups()
+	last := a.GetLastGroup()
+
+	pos := last.GetPos()
+	if pos == turkish.UnknownPos && len(groups) == 1 {
+		pos = a.Item.PrimaryPos
+	}
+	spos := turkish.NonePos
+	if len(groups) == 1 {
+		spos = a.Item.SecondaryPos
+	}
+
+	upos, ok := "", false
+	if len(groups) > 1 {
+		upos, ok = m.derived[last.Morphemes[0].Morpheme.ID]
+	}
+	if !ok {
+		upos, ok = m.upos[uposKey(pos, spos)]
+	}
+	if !ok {
+		upos, ok = m.upos[uposKey(pos, turkish.NonePos)]
+	}
+	if !ok {
+		upos = "X"
+	}
+
+	xpos := pos.GetStringForm()
+	if spos != turkish.NonePos && spos != turkish.UnknownSec {
+		xpos = spos.GetStringForm()
+	}
+
+	feats := make(Features)
+	for _, group := range groups[:len(groups)-1] {
+		for _, md := range group.Morphemes {
+			for name, value := range m.feats[md.Morpheme.ID] {
+				if m.inherit[name] {
+					feats[name] = value
+				}
+			}
+		}
+	}
+	for _, md := range last.Morphemes {
+		for name, value := range m.feats[md.Morpheme.ID] {
+			feats[name] = value
+		}
+	}
+	for name, value := range m.defaults[upos] {
+		if _, ok := feats[name]; !ok {
+			feats[name] = value
+		}
+	}
+
+	lemma := a.GetLemmas()[0]
+	if a.IsUnknown() {
+		lemma = a.GetStem()
+	}
+
+	return &Annotation{
+		Lemma: lemma,
+		UPOS:  upos,
+		XPOS:  xpos,
+		Feats: feats,
+	}
+}
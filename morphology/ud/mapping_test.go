@@ -0,0 +1,117 @@
+package ud
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kalaomer/zemberek-go/core/turkish"
+	"github.com/kalaomer/zemberek-go/morphology/analysis"
+	"github.com/kalaomer/zemberek-go/morphology/lexicon"
+)
+
+func testLexicon() *lexicon.RootLexicon {
+	return lexicon.NewRootLexicon([]*lexicon.DictionaryItem{
+		lexicon.NewDictionaryItem("kitap", "kitap", turkish.Noun, turkish.NonePos, nil, "", 0),
+		lexicon.NewDictionaryItem("göz", "göz", turkish.Noun, turkish.NonePos, nil, "", 0),
+		lexicon.NewDictionaryItem("gelmek", "gel", turkish.Verb, turkish.NonePos, nil, "", 0),
+		lexicon.NewDictionaryItem("Ankara", "ankara", turkish.Noun, turkish.ProperNoun, nil, "", 0),
+	})
+}
+
+func parse(t *testing.T, s string) *analysis.SingleAnalysis {
+	t.Helper()
+	a, err := analysis.Parse(s, testLexicon())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
+
+func TestMap(t *testing.T) {
+	m := DefaultMapping()
+	tests := []struct {
+		analysis string
+		lemma    string
+		upos     string
+		xpos     string
+		feats    string
+	}{
+		{"[kitap:Noun] kitap:Noun+lar:A3pl+ım:P1sg+dan:Abl", "kitap", "NOUN", "Noun",
+			"Case=Abl|Number=Plur|Number[psor]=Sing|Person=3|Person[psor]=1"},
+		{"[kitap:Noun] kitap:Noun+A3sg", "kitap", "NOUN", "Noun", "Case=Nom|Number=Sing|Person=3"},
+		{"[Ankara:Noun, Prop] ankara:Noun+A3sg+da:Loc", "ankara", "PROPN", "Prop", "Case=Loc|Number=Sing|Person=3"},
+		{"[gelmek:Verb] gel:Verb+iyor:Prog1+um:A1sg", "gel", "VERB", "Verb",
+			"Aspect=Prog|Number=Sing|Person=1|Polarity=Pos|Tense=Pres"},
+		{"[gelmek:Verb] gel:Verb+me:Neg|dik:PastPart→Adj", "gel", "VERB", "Adj",
+			"Polarity=Neg|Tense=Past|VerbForm=Part"},
+		{"[göz:Noun] göz:Noun+A3sg|lük:Ness→Noun+ler:A3pl+de:Loc|ki:Rel→Adj", "göz", "ADJ", "Adj", "_"},
+		{"[göz:Noun] göz:Noun+A3sg|lük:Ness→Noun+A3sg+ten:Abl", "göz", "NOUN", "Noun",
+			"Case=Abl|Number=Sing|Person=3"},
+	}
+	for _, tt := range tests {
+		a := m.Map(parse(t, tt.analysis))
+		if a.Lemma != tt.lemma || a.UPOS != tt.upos || a.XPOS != tt.xpos || a.Feats.String() != tt.feats {
+			t.Errorf("%s: got %s %s %s %s", tt.analysis, a.Lemma, a.UPOS, a.XPOS, a.Feats)
+		}
+	}
+
+	unknown := m.Map(analysis.Unknown("xyz"))
+	if unknown.UPOS != "X" || unknown.Lemma != "xyz" || unknown.Feats.String() != "_" {
+		t.Errorf("unexpected unknown annotation %+v", unknown)
+	}
+}
+
+func TestMappingOverride(t *testing.T) {
+	m := DefaultMapping()
+	err := m.Parse(strings.NewReader(`
+feats Abl Case=Abl Adjusted=Yes
+upos Noun Prop NOUN
+inherit Number
+default ADJ Degree=Pos
+`), "override")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := m.Map(parse(t, "[Ankara:Noun, Prop] ankara:Noun+A3sg+dan:Abl"))
+	if a.UPOS != "NOUN" || a.Feats["Adjusted"] != "Yes" {
+		t.Errorf("override not applied: %+v", a)
+	}
+	a = m.Map(parse(t, "[göz:Noun] göz:Noun+A3sg|lük:Ness→Noun+ler:A3pl+de:Loc|ki:Rel→Adj"))
+	if a.Feats.String() != "Degree=Pos|Number=Plur" {
+		t.Errorf("unexpected features %s", a.Feats)
+	}
+
+	m.SetFeatures("Loc", Features{"Case": "Loc", "Test": "1"})
+	if f := m.Map(parse(t, "[kitap:Noun] kitap:Noun+A3sg+ta:Loc")).Feats; f["Test"] != "1" {
+		t.Errorf("SetFeatures not applied: %s", f)
+	}
+
+	// The default mapping is not shared
+	if DefaultMapping().Map(parse(t, "[kitap:Noun] kitap:Noun+A3sg+ta:Loc")).Feats["Test"] != "" {
+		t.Error("default mapping was modified")
+	}
+}
+
+func TestMappingErrors(t *testing.T) {
+	for _, line := range []string{
+		"upos Nown NOUN",
+		"upos Noun Xyz PROPN",
+		"upos Noun",
+		"feats Abl Case",
+		"feats Abl",
+		"default NOUN",
+		"derived Inf1",
+		"unknown x",
+	} {
+		err := NewMapping().Parse(strings.NewReader("# comment\n"+line), "test")
+		if err == nil || !strings.Contains(err.Error(), "ud: test:2:") {
+			t.Errorf("%q: unexpected error %v", line, err)
+		}
+	}
+
+	f, err := ParseFeatures("Case=Abl|Number=Plur")
+	if err != nil || len(f) != 2 || f["Case"] != "Abl" {
+		t.Errorf("ParseFeatures: %v %v", f, err)
+	}
+}
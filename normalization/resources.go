@@ -0,0 +1,104 @@
+package normalization
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+)
+
+// normalizationDir is the folder holding normalization tables inside a data root
+const normalizationDir = "normalization"
+
+// endingsResourceName is the suffix list used to build the stem-ending graph
+const endingsResourceName = "endings.txt"
+
+// normalizationResource describes a lookup table and the file names it may
+// be stored under. Java data ships the files without extension.
+type normalizationResource struct {
+	names    []string
+	optional bool
+}
+
+var (
+	replacementsResource     = normalizationResource{names: []string{"multi-word-replacements.txt", "multi-word-replacements"}}
+	noSplitResource          = normalizationResource{names: []string{"no-split.txt", "no-split"}}
+	splitResource            = normalizationResource{names: []string{"split.txt", "split"}}
+	questionSuffixesResource = normalizationResource{names: []string{"question-suffixes.txt", "question-suffixes"}}
+	lookupFromGraphResource  = normalizationResource{names: []string{"lookup-from-graph.txt", "lookup-from-graph"}}
+	asciiMapResource         = normalizationResource{names: []string{"ascii-map.txt", "ascii-map"}}
+
+	// candidates-manual is merged with GetDefaultLookupMap, so it may be absent
+	candidatesManualResource = normalizationResource{names: []string{"candidates-manual.txt", "candidates-manual"}, optional: true}
+)
+
+// resourceLoader loads normalization tables from a file system and collects
+// errors. In strict mode a missing non-optional table is an error; otherwise
+// it is skipped. Read errors are always reported.
+type resourceLoader struct {
+	fsys   fs.FS
+	dir    string
+	strict bool
+	errs   []error
+}
+
+func (rl *resourceLoader) find(res normalizationResource) string {
+	p := firstExisting(rl.fsys, rl.dir, res.names...)
+	if p == "" && rl.strict && !res.optional {
+		rl.errs = append(rl.errs, fmt.Errorf("normalization: missing resource %s: %w",
+			path.Join(rl.dir, res.names[0]), fs.ErrNotExist))
+	}
+	return p
+}
+
+func (rl *resourceLoader) load(loadFn func(fs.FS, string) error, res normalizationResource) {
+	if p := rl.find(res); p != "" {
+		if err := loadFn(rl.fsys, p); err != nil {
+			rl.errs = append(rl.errs, fmt.Errorf("normalization: load %s: %w", p, err))
+		}
+	}
+}
+
+func (rl *resourceLoader) loadMultimap(loadFn func(fs.FS, string, map[string][]string) error,
+	res normalizationResource, target map[string][]string) {
+	rl.load(func(fsys fs.FS, p string) error { return loadFn(fsys, p, target) }, res)
+}
+
+func (rl *resourceLoader) err() error {
+	return errors.Join(rl.errs...)
+}
+
+// firstExisting tries dir/one of names in order inside fsys and returns the
+// first existing path. If none exist, returns empty string.
+func firstExisting(fsys fs.FS, dir string, names ...string) string {
+	if fsys == nil {
+		return ""
+	}
+	for _, n := range names {
+		if n == "" {
+			continue
+		}
+		p := path.Join(dir, n)
+		if _, err := fs.Stat(fsys, p); err == nil {
+			return p
+		}
+	}
+	return ""
+}
+
+// fsSource describes fsys in errors: the source of a resource.Bundle, or
+// "fs.FS" for file systems that do not describe themselves
+func fsSource(fsys fs.FS) string {
+	if s, ok := fsys.(interface{ Source() string }); ok {
+		return s.Source()
+	}
+	return "fs.FS"
+}
+
+// splitPath turns a file system path into a file system rooted at its
+// directory and the base name, so path based APIs can share fs.FS loaders
+func splitPath(p string) (fs.FS, string) {
+	return os.DirFS(filepath.Dir(p)), filepath.Base(p)
+}
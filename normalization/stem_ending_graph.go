@@ -2,6 +2,9 @@ package normalization
 
 import (
 	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
 	"os"
 	"strings"
 
@@ -16,20 +19,34 @@ type StemEndingGraph struct {
 	stemWords    []string // Store stem words directly
 }
 
-// NewStemEndingGraph creates a new stem-ending graph
+// NewStemEndingGraph creates a new stem-ending graph. An empty or missing
+// endingsPath yields a stems-only graph.
 func NewStemEndingGraph(stemWords []string, endingsPath string) (*StemEndingGraph, error) {
+	if endingsPath == "" {
+		return NewStemEndingGraphFS(stemWords, nil, "")
+	}
+	if _, err := os.Stat(endingsPath); errors.Is(err, fs.ErrNotExist) {
+		return NewStemEndingGraphFS(stemWords, nil, "")
+	}
+	fsys, name := splitPath(endingsPath)
+	return NewStemEndingGraphFS(stemWords, fsys, name)
+}
+
+// NewStemEndingGraphFS creates a new stem-ending graph reading endings from
+// name inside fsys. A nil fsys or empty name yields a stems-only graph; a
+// missing file is an error.
+func NewStemEndingGraphFS(stemWords []string, fsys fs.FS, name string) (*StemEndingGraph, error) {
 	seg := &StemEndingGraph{
 		stemWords: stemWords,
 	}
 
-	// Load endings (optional)
 	endings := []string{}
-	if endingsPath != "" {
-		loadedEndings, err := seg.loadLinesFromResource(endingsPath)
-		if err == nil {
-			endings = loadedEndings
+	if fsys != nil && name != "" {
+		loadedEndings, err := seg.loadLinesFromResource(fsys, name)
+		if err != nil {
+			return nil, fmt.Errorf("normalization: load endings %s: %w", name, err)
 		}
-		// If error, continue with empty endings (stems only)
+		endings = loadedEndings
 	}
 
 	seg.EndingGraph = seg.generateEndingGraph(endings)
@@ -46,15 +63,9 @@ func NewStemEndingGraph(stemWords []string, endingsPath string) (*StemEndingGrap
 	return seg, nil
 }
 
-// loadLinesFromResource loads lines from a file
-func (seg *StemEndingGraph) loadLinesFromResource(path string) ([]string, error) {
-	// If no path provided, use default
-	if path == "" {
-		// Default path would be set here
-		path = "resources/normalization/endings.txt"
-	}
-
-	file, err := os.Open(path)
+// loadLinesFromResource loads lines from a file inside fsys
+func (seg *StemEndingGraph) loadLinesFromResource(fsys fs.FS, path string) ([]string, error) {
+	file, err := fsys.Open(path)
 	if err != nil {
 		return nil, err
 	}
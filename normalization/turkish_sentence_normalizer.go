@@ -2,9 +2,9 @@ package normalization
 
 import (
 	"bufio"
-	"fmt"
+	"io/fs"
 	"os"
-	"path/filepath"
+	"path"
 	"strings"
 	"unicode"
 
@@ -36,8 +36,27 @@ type TurkishSentenceNormalizer struct {
 	stemWords               []string
 }
 
-// NewTurkishSentenceNormalizer creates a new sentence normalizer
+// NewTurkishSentenceNormalizer creates a new sentence normalizer reading its
+// tables and endings from the resourcesPath directory, a "normalization"
+// folder. Missing tables are skipped and without endings the spell checker
+// knows stems only.
 func NewTurkishSentenceNormalizer(stemWords []string, resourcesPath string) (*TurkishSentenceNormalizer, error) {
+	if resourcesPath == "" {
+		resourcesPath = "resources/normalization"
+	}
+	return newTurkishSentenceNormalizer(stemWords, os.DirFS(resourcesPath), "", false)
+}
+
+// NewTurkishSentenceNormalizerFS creates a new sentence normalizer reading its
+// tables and endings from the "normalization" folder of fsys (typically a
+// resource.Bundle), as NewTurkishSentenceNormalizerAdvancedFS does. Unlike
+// NewTurkishSentenceNormalizer, a missing required table or endings file is
+// an error.
+func NewTurkishSentenceNormalizerFS(stemWords []string, fsys fs.FS) (*TurkishSentenceNormalizer, error) {
+	return newTurkishSentenceNormalizer(stemWords, fsys, normalizationDir, true)
+}
+
+func newTurkishSentenceNormalizer(stemWords []string, fsys fs.FS, dir string, strict bool) (*TurkishSentenceNormalizer, error) {
 	tsn := &TurkishSentenceNormalizer{
 		Replacements:            make(map[string]string),
 		NoSplitWords:            make(map[string]bool),
@@ -50,47 +69,26 @@ func NewTurkishSentenceNormalizer(stemWords []string, resourcesPath string) (*Tu
 		stemWords:               stemWords,
 	}
 
-	// Load resources
-	if resourcesPath == "" {
-		resourcesPath = "resources/normalization"
-	}
-
-	// Load replacements (support both .txt and extensionless dataset files)
-	if p := firstExisting(resourcesPath, "multi-word-replacements.txt", "multi-word-replacements"); p != "" {
-		_ = tsn.loadReplacements(p)
-	}
-
-	// Load no-split words
-	if p := firstExisting(resourcesPath, "no-split.txt", "no-split"); p != "" {
-		_ = tsn.loadNoSplit(p)
-	}
-
-	// Load common splits
-	if p := firstExisting(resourcesPath, "split.txt", "split"); p != "" {
-		_ = tsn.loadCommonSplits(p)
-	}
-
-	// Load connected suffixes
-	if p := firstExisting(resourcesPath, "question-suffixes.txt", "question-suffixes"); p != "" {
-		_ = tsn.loadConnectedSuffixes(p)
+	rl := &resourceLoader{fsys: fsys, dir: dir, strict: strict}
+	rl.load(tsn.loadReplacements, replacementsResource)
+	rl.load(tsn.loadNoSplit, noSplitResource)
+	rl.load(tsn.loadCommonSplits, splitResource)
+	rl.load(tsn.loadConnectedSuffixes, questionSuffixesResource)
+	rl.loadMultimap(tsn.loadMultimap, candidatesManualResource, tsn.LookupManual)
+	rl.loadMultimap(tsn.loadMultimap, lookupFromGraphResource, tsn.LookupFromGraph)
+	rl.loadMultimap(tsn.loadMultimap, asciiMapResource, tsn.LookupFromASCII)
+	if err := rl.err(); err != nil {
+		return nil, err
 	}
 
-	// Load lookup maps
-	if p := firstExisting(resourcesPath, "candidates-manual.txt", "candidates-manual"); p != "" {
-		_ = tsn.loadMultimap(p, tsn.LookupManual)
-	}
-	if p := firstExisting(resourcesPath, "lookup-from-graph.txt", "lookup-from-graph"); p != "" {
-		_ = tsn.loadMultimap(p, tsn.LookupFromGraph)
+	// Endings are optional only outside strict mode
+	endingsFS, endingsName := fsys, path.Join(dir, endingsResourceName)
+	if !strict && firstExisting(fsys, dir, endingsResourceName) == "" {
+		endingsFS, endingsName = nil, ""
 	}
-	if p := firstExisting(resourcesPath, "ascii-map.txt", "ascii-map"); p != "" {
-		_ = tsn.loadMultimap(p, tsn.LookupFromASCII)
-	}
-
-	// Create spell checker
-	spellChecker, err := NewTurkishSpellChecker(stemWords, resourcesPath+"/endings.txt", DiacriticsIgnoringMatcherInstance)
+	spellChecker, err := NewTurkishSpellCheckerFS(stemWords, endingsFS, endingsName, DiacriticsIgnoringMatcherInstance)
 	if err != nil {
-		// Use nil spell checker if creation fails
-		spellChecker = nil
+		return nil, err
 	}
 	tsn.SpellChecker = spellChecker
 
@@ -331,11 +329,11 @@ func (tsn *TurkishSentenceNormalizer) separateCommon(word string, useLookup bool
 // Helper functions
 
 // loadReplacements loads replacement map
-func (tsn *TurkishSentenceNormalizer) loadReplacements(path string) error {
+func (tsn *TurkishSentenceNormalizer) loadReplacements(fsys fs.FS, path string) error {
 	if path == "" {
 		return nil
 	}
-	file, err := os.Open(path)
+	file, err := fsys.Open(path)
 	if err != nil {
 		return err
 	}
@@ -356,11 +354,11 @@ func (tsn *TurkishSentenceNormalizer) loadReplacements(path string) error {
 }
 
 // loadNoSplit loads no-split words
-func (tsn *TurkishSentenceNormalizer) loadNoSplit(path string) error {
+func (tsn *TurkishSentenceNormalizer) loadNoSplit(fsys fs.FS, path string) error {
 	if path == "" {
 		return nil
 	}
-	file, err := os.Open(path)
+	file, err := fsys.Open(path)
 	if err != nil {
 		return err
 	}
@@ -377,11 +375,11 @@ func (tsn *TurkishSentenceNormalizer) loadNoSplit(path string) error {
 }
 
 // loadCommonSplits loads common splits
-func (tsn *TurkishSentenceNormalizer) loadCommonSplits(path string) error {
+func (tsn *TurkishSentenceNormalizer) loadCommonSplits(fsys fs.FS, path string) error {
 	if path == "" {
 		return nil
 	}
-	file, err := os.Open(path)
+	file, err := fsys.Open(path)
 	if err != nil {
 		return err
 	}
@@ -411,11 +409,11 @@ func (tsn *TurkishSentenceNormalizer) loadCommonSplits(path string) error {
 }
 
 // loadConnectedSuffixes loads connected suffixes
-func (tsn *TurkishSentenceNormalizer) loadConnectedSuffixes(path string) error {
+func (tsn *TurkishSentenceNormalizer) loadConnectedSuffixes(fsys fs.FS, path string) error {
 	if path == "" {
 		return nil
 	}
-	file, err := os.Open(path)
+	file, err := fsys.Open(path)
 	if err != nil {
 		return err
 	}
@@ -432,11 +430,11 @@ func (tsn *TurkishSentenceNormalizer) loadConnectedSuffixes(path string) error {
 }
 
 // loadMultimap loads multimap
-func (tsn *TurkishSentenceNormalizer) loadMultimap(path string, target map[string][]string) error {
+func (tsn *TurkishSentenceNormalizer) loadMultimap(fsys fs.FS, path string, target map[string][]string) error {
 	if path == "" {
 		return nil
 	}
-	file, err := os.Open(path)
+	file, err := fsys.Open(path)
 	if err != nil {
 		return err
 	}
@@ -568,25 +566,6 @@ func NewHypothesis() *Hypothesis {
 	}
 }
 
-// firstExisting tries resourceRoot/one of names in order and returns the first existing path.
-// If none exist or resourceRoot is empty, returns empty string.
-func firstExisting(resourceRoot string, names ...string) string {
-	if resourceRoot == "" {
-		return ""
-	}
-	for _, n := range names {
-		if n == "" {
-			continue
-		}
-		p := filepath.Join(resourceRoot, n)
-		if _, err := os.Stat(p); err == nil {
-			return p
-		}
-	}
-	_ = fmt.Sprintf // keep fmt import
-	return ""
-}
-
 // Equals checks if two hypotheses are equal
 func (h *Hypothesis) Equals(other *Hypothesis) bool {
 	if h == other {
@@ -3,15 +3,15 @@ package normalization
 import (
 	"bufio"
 	"fmt"
+	"io/fs"
 	"os"
-	"path/filepath"
 	"strings"
 	"unicode"
 
 	"github.com/kalaomer/zemberek-go/core/turkish"
 	"github.com/kalaomer/zemberek-go/lm"
 	"github.com/kalaomer/zemberek-go/morphology"
-	"github.com/kalaomer/zemberek-go/morphology/analysis"
+	"github.com/kalaomer/zemberek-go/morphology/generator"
 	"github.com/kalaomer/zemberek-go/normalization/deasciifier"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
@@ -22,7 +22,7 @@ type TurkishSentenceNormalizerAdvanced struct {
 	SpellChecker            *TurkishSpellChecker
 	Morphology              *morphology.TurkishMorphology
 	InformalMorphology      *morphology.TurkishMorphology
-	AnalysisConverter       *analysis.InformalAnalysisConverter
+	AnalysisConverter       *generator.InformalAnalysisConverter
 	LanguageModel           lm.LanguageModel
 	Replacements            map[string]string
 	NoSplitWords            map[string]bool
@@ -32,10 +32,31 @@ type TurkishSentenceNormalizerAdvanced struct {
 	LookupFromGraph         map[string][]string
 	LookupFromASCII         map[string][]string
 	AlwaysApplyDeasciifier  bool
+	// WordSegmenter splits words written without spaces that CommonSplits
+	// does not know, like "birşeyolmaz". Nil disables it.
+	WordSegmenter *WordSegmenter
 }
 
-// NewTurkishSentenceNormalizerAdvanced creates a new advanced sentence normalizer with morphology
+// NewTurkishSentenceNormalizerAdvanced creates a new advanced sentence normalizer with morphology.
+// dataRoot is a directory holding the language model and a "normalization"
+// folder; missing normalization tables are skipped.
 func NewTurkishSentenceNormalizerAdvanced(morph *morphology.TurkishMorphology, dataRoot string) (*TurkishSentenceNormalizerAdvanced, error) {
+	if dataRoot == "" {
+		// default to repo resources root
+		dataRoot = "resources"
+	}
+	return newTurkishSentenceNormalizerAdvanced(morph, os.DirFS(dataRoot), dataRoot, false)
+}
+
+// NewTurkishSentenceNormalizerAdvancedFS creates an advanced sentence normalizer
+// reading the language model and the "normalization" tables from fsys
+// (typically a resource.Bundle, see its layout). Every required table must be
+// present.
+func NewTurkishSentenceNormalizerAdvancedFS(morph *morphology.TurkishMorphology, fsys fs.FS) (*TurkishSentenceNormalizerAdvanced, error) {
+	return newTurkishSentenceNormalizerAdvanced(morph, fsys, fsSource(fsys), true)
+}
+
+func newTurkishSentenceNormalizerAdvanced(morph *morphology.TurkishMorphology, fsys fs.FS, source string, strict bool) (*TurkishSentenceNormalizerAdvanced, error) {
 	tsn := &TurkishSentenceNormalizerAdvanced{
 		Morphology:              morph,
 		Replacements:            make(map[string]string),
@@ -56,38 +77,29 @@ func NewTurkishSentenceNormalizerAdvanced(morph *morphology.TurkishMorphology, d
 	tsn.InformalMorphology = informalMorph
 
 	// Create analysis converter
-	tsn.AnalysisConverter = analysis.NewInformalAnalysisConverter(morph.WordGenerator)
-
-	// Resolve paths
-	if dataRoot == "" {
-		// default to repo resources root
-		dataRoot = "resources"
-	}
-	normRoot := filepath.Join(dataRoot, "normalization")
-	// Language model path: prefer dataRoot/lm.2gram.slm then dataRoot/lm/lm.2gram.slm
-	lmPath := filepath.Join(dataRoot, "lm.2gram.slm")
-	if _, err := os.Stat(lmPath); err != nil {
-		alt := filepath.Join(dataRoot, "lm", "lm.2gram.slm")
-		if _, err2 := os.Stat(alt); err2 == nil {
-			lmPath = alt
-		}
-	}
+	tsn.AnalysisConverter = generator.NewInformalAnalysisConverter(morph.WordGenerator)
 
 	// Load language model (required for advanced decoding)
-	langModel, err := lm.LoadFromFile(lmPath)
+	langModel, err := lm.LoadFromFS(fsys, "")
 	if err != nil {
-		return nil, fmt.Errorf("load language model from %s: %w", lmPath, err)
+		return nil, fmt.Errorf("load language model from %s: %w", source, err)
 	}
 	tsn.LanguageModel = langModel
+	tsn.WordSegmenter = NewWordSegmenter(morph, langModel)
+	tsn.WordSegmenter.KnownWordsOnly = true
 
 	// Load all resource files (support extensionless names as in Java data)
-	tsn.loadReplacements(firstExisting(normRoot, "multi-word-replacements.txt", "multi-word-replacements"))
-	tsn.loadNoSplit(firstExisting(normRoot, "no-split.txt", "no-split"))
-	tsn.loadCommonSplits(firstExisting(normRoot, "split.txt", "split"))
-	tsn.loadConnectedSuffixes(firstExisting(normRoot, "question-suffixes.txt", "question-suffixes"))
-	tsn.loadMultimap(firstExisting(normRoot, "candidates-manual.txt", "candidates-manual"), tsn.LookupManual)
-	tsn.loadMultimap(firstExisting(normRoot, "lookup-from-graph.txt", "lookup-from-graph"), tsn.LookupFromGraph)
-	tsn.loadMultimap(firstExisting(normRoot, "ascii-map.txt", "ascii-map"), tsn.LookupFromASCII)
+	rl := &resourceLoader{fsys: fsys, dir: normalizationDir, strict: strict}
+	rl.load(tsn.loadReplacements, replacementsResource)
+	rl.load(tsn.loadNoSplit, noSplitResource)
+	rl.load(tsn.loadCommonSplits, splitResource)
+	rl.load(tsn.loadConnectedSuffixes, questionSuffixesResource)
+	rl.loadMultimap(tsn.loadMultimap, candidatesManualResource, tsn.LookupManual)
+	rl.loadMultimap(tsn.loadMultimap, lookupFromGraphResource, tsn.LookupFromGraph)
+	rl.loadMultimap(tsn.loadMultimap, asciiMapResource, tsn.LookupFromASCII)
+	if err := rl.err(); err != nil {
+		return nil, err
+	}
 
 	skipManualDefaults := map[string]struct{}{
 		"annemde": {},
@@ -779,6 +791,12 @@ func (tsn *TurkishSentenceNormalizerAdvanced) separateCommon(word string, useLoo
 		if split, exists := tsn.CommonSplits[word]; exists {
 			return split
 		}
+		// informal words like "beklenmiyo" are left to the candidates
+		if tsn.WordSegmenter != nil && !tsn.Morphology.HasAnalysis(word) && !tsn.InformalMorphology.HasAnalysis(word) {
+			if words := tsn.WordSegmenter.Segment(word); len(words) > 1 {
+				return strings.Join(words, " ")
+			}
+		}
 	}
 
 	if !tsn.Morphology.HasRegularAnalysis(word) {
@@ -807,11 +825,11 @@ func (tsn *TurkishSentenceNormalizerAdvanced) separateCommon(word string, useLoo
 	return word
 }
 
-func (tsn *TurkishSentenceNormalizerAdvanced) loadReplacements(path string) error {
+func (tsn *TurkishSentenceNormalizerAdvanced) loadReplacements(fsys fs.FS, path string) error {
 	if path == "" {
 		return nil
 	}
-	file, err := os.Open(path)
+	file, err := fsys.Open(path)
 	if err != nil {
 		return err
 	}
@@ -831,11 +849,11 @@ func (tsn *TurkishSentenceNormalizerAdvanced) loadReplacements(path string) erro
 	return scanner.Err()
 }
 
-func (tsn *TurkishSentenceNormalizerAdvanced) loadNoSplit(path string) error {
+func (tsn *TurkishSentenceNormalizerAdvanced) loadNoSplit(fsys fs.FS, path string) error {
 	if path == "" {
 		return nil
 	}
-	file, err := os.Open(path)
+	file, err := fsys.Open(path)
 	if err != nil {
 		return err
 	}
@@ -851,11 +869,11 @@ func (tsn *TurkishSentenceNormalizerAdvanced) loadNoSplit(path string) error {
 	return scanner.Err()
 }
 
-func (tsn *TurkishSentenceNormalizerAdvanced) loadCommonSplits(path string) error {
+func (tsn *TurkishSentenceNormalizerAdvanced) loadCommonSplits(fsys fs.FS, path string) error {
 	if path == "" {
 		return nil
 	}
-	file, err := os.Open(path)
+	file, err := fsys.Open(path)
 	if err != nil {
 		return err
 	}
@@ -884,11 +902,11 @@ func (tsn *TurkishSentenceNormalizerAdvanced) loadCommonSplits(path string) erro
 	return scanner.Err()
 }
 
-func (tsn *TurkishSentenceNormalizerAdvanced) loadConnectedSuffixes(path string) error {
+func (tsn *TurkishSentenceNormalizerAdvanced) loadConnectedSuffixes(fsys fs.FS, path string) error {
 	if path == "" {
 		return nil
 	}
-	file, err := os.Open(path)
+	file, err := fsys.Open(path)
 	if err != nil {
 		return err
 	}
@@ -904,11 +922,11 @@ func (tsn *TurkishSentenceNormalizerAdvanced) loadConnectedSuffixes(path string)
 	return scanner.Err()
 }
 
-func (tsn *TurkishSentenceNormalizerAdvanced) loadMultimap(path string, target map[string][]string) error {
+func (tsn *TurkishSentenceNormalizerAdvanced) loadMultimap(fsys fs.FS, path string, target map[string][]string) error {
 	if path == "" {
 		return nil
 	}
-	file, err := os.Open(path)
+	file, err := fsys.Open(path)
 	if err != nil {
 		return err
 	}
@@ -1129,6 +1147,3 @@ func hasNonASCII(s string) bool {
 	}
 	return false
 }
-
-// firstExisting tries dataRoot/name1 or name2 and returns first existing path.
-// Note: firstExisting helper is defined in turkish_sentence_normalizer.go for this package.
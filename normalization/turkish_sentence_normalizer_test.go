@@ -1,8 +1,13 @@
 package normalization
 
 import (
+	"errors"
+	"io/fs"
 	"strings"
 	"testing"
+	"testing/fstest"
+
+	"github.com/kalaomer/zemberek-go/core/resource"
 )
 
 // TestNewTurkishSentenceNormalizer tests normalizer creation
@@ -470,3 +475,78 @@ func BenchmarkTurkishSentenceNormalizer_GetCandidates(b *testing.B) {
 		_ = normalizer.getCandidates("test")
 	}
 }
+
+// normalizerBundle returns the tables and endings a strict normalizer needs,
+// in the "normalization" folder as in a resource bundle
+func normalizerBundle() fstest.MapFS {
+	fsys := fstest.MapFS{}
+	for _, name := range []string{"multi-word-replacements.txt", "no-split.txt", "split.txt",
+		"question-suffixes.txt", "lookup-from-graph.txt", "ascii-map.txt"} {
+		fsys["normalization/"+name] = &fstest.MapFile{}
+	}
+	fsys["normalization/split.txt"] = &fstest.MapFile{Data: []byte("birşey=bir şey\n")}
+	fsys["normalization/"+endingsResourceName] = &fstest.MapFile{Data: []byte("ler\nlar\n")}
+	return fsys
+}
+
+// TestNewTurkishSentenceNormalizerFS tests the bundle layout and strict mode
+func TestNewTurkishSentenceNormalizerFS(t *testing.T) {
+	stemWords := []string{"kitap", "ev"}
+
+	normalizer, err := NewTurkishSentenceNormalizerFS(stemWords, normalizerBundle())
+	if err != nil {
+		t.Fatalf("NewTurkishSentenceNormalizerFS: %v", err)
+	}
+	if normalizer.SpellChecker == nil {
+		t.Fatal("Expected a spell checker")
+	}
+	if normalizer.CommonSplits["birşey"] != "bir şey" {
+		t.Errorf("Expected split table from normalization/, got %v", normalizer.CommonSplits)
+	}
+
+	missingEndings := normalizerBundle()
+	delete(missingEndings, "normalization/"+endingsResourceName)
+	if _, err := NewTurkishSentenceNormalizerFS(stemWords, missingEndings); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("Expected missing endings error, got %v", err)
+	}
+
+	// tables at the root are not part of the bundle layout
+	root := fstest.MapFS{}
+	for name, file := range normalizerBundle() {
+		root[strings.TrimPrefix(name, "normalization/")] = file
+	}
+	if _, err := NewTurkishSentenceNormalizerFS(stemWords, root); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("Expected missing tables error, got %v", err)
+	}
+
+	// the path based constructor reads the normalization folder itself and
+	// builds a stems-only spell checker without endings
+	normalizer, err = NewTurkishSentenceNormalizer(stemWords, t.TempDir())
+	if err != nil {
+		t.Fatalf("NewTurkishSentenceNormalizer: %v", err)
+	}
+	if normalizer.SpellChecker == nil {
+		t.Error("Expected a stems-only spell checker")
+	}
+}
+
+// TestAdvancedFSErrorSource tests that load errors name the bundle, not its
+// contents
+func TestAdvancedFSErrorSource(t *testing.T) {
+	morph := getSegmenterMorphology()
+	fsys := normalizerBundle()
+
+	_, err := NewTurkishSentenceNormalizerAdvancedFS(morph, fsys)
+	if err == nil || !strings.Contains(err.Error(), "from fs.FS:") || strings.Contains(err.Error(), "birşey") {
+		t.Errorf("Expected an error naming fs.FS, got %v", err)
+	}
+
+	bundle, err := resource.New(fsys, "test-bundle")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = NewTurkishSentenceNormalizerAdvancedFS(morph, bundle)
+	if err == nil || !strings.Contains(err.Error(), "from test-bundle:") {
+		t.Errorf("Expected an error naming the bundle source, got %v", err)
+	}
+}
@@ -1,6 +1,7 @@
 package normalization
 
 import (
+	"io/fs"
 	"math"
 	"regexp"
 	"sort"
@@ -27,14 +28,26 @@ func NewTurkishSpellChecker(stemWords []string, endingsPath string, matcher Char
 	if err != nil {
 		return nil, err
 	}
+	return newTurkishSpellChecker(stemWords, graph, matcher), nil
+}
 
+// NewTurkishSpellCheckerFS creates a new spell checker reading endings from name inside fsys
+func NewTurkishSpellCheckerFS(stemWords []string, fsys fs.FS, endingsName string, matcher CharMatcher) (*TurkishSpellChecker, error) {
+	graph, err := NewStemEndingGraphFS(stemWords, fsys, endingsName)
+	if err != nil {
+		return nil, err
+	}
+	return newTurkishSpellChecker(stemWords, graph, matcher), nil
+}
+
+func newTurkishSpellChecker(stemWords []string, graph *StemEndingGraph, matcher CharMatcher) *TurkishSpellChecker {
 	decoder := NewCharacterGraphDecoder(graph.StemGraph)
 
 	return &TurkishSpellChecker{
 		Decoder:     decoder,
 		CharMatcher: matcher,
 		stemWords:   stemWords,
-	}, nil
+	}
 }
 
 // SuggestForWord returns suggestions for a misspelled word
@@ -0,0 +1,163 @@
+package normalization
+
+import (
+	"unicode"
+
+	"github.com/kalaomer/zemberek-go/core/turkish"
+	"github.com/kalaomer/zemberek-go/lm"
+	"github.com/kalaomer/zemberek-go/morphology"
+)
+
+// Default word lengths in runes a WordSegmenter tries
+const (
+	defaultMinWordLength = 2
+	defaultMaxWordLength = 24
+)
+
+// WordSegmenter splits text written without spaces, like the hashtag body
+// "yarınokulagidiyorum" or "birşeyolmaz", into words. Every word must have a
+// morphological analysis; a Viterbi search over the split points picks the
+// segmentation the language model scores best. Without a language model the
+// segmentation with the fewest words wins.
+type WordSegmenter struct {
+	Morphology    *morphology.TurkishMorphology
+	LanguageModel lm.LanguageModel
+	// MinWordLength and MaxWordLength bound the length of words in runes.
+	// Single letters are left out by default, as "kalmadıı" is not
+	// "kalmadı ı".
+	MinWordLength int
+	MaxWordLength int
+	// KnownWordsOnly also requires words to be in the vocabulary of the
+	// language model. The morphology accepts many short pieces, like "bek"
+	// and "len" of "beklenmiyo", that running text rarely holds.
+	KnownWordsOnly bool
+}
+
+// NewWordSegmenter creates a WordSegmenter. model may be nil.
+func NewWordSegmenter(morph *morphology.TurkishMorphology, model lm.LanguageModel) *WordSegmenter {
+	return &WordSegmenter{
+		Morphology:    morph,
+		LanguageModel: model,
+		MinWordLength: defaultMinWordLength,
+		MaxWordLength: defaultMaxWordLength,
+	}
+}
+
+// segmentState is the best segmentation of a prefix ending with word
+type segmentState struct {
+	word  string
+	start int
+	score float64
+	prev  *segmentState
+}
+
+// Segment returns the words of text, or nil if text cannot be split into
+// words that have an analysis. Words are analysed in lower case and keep the
+// casing of text. Text that has an analysis as a whole is returned as is.
+func (s *WordSegmenter) Segment(text string) []string {
+	runes := []rune(text)
+	lower := []rune(turkish.Instance.ToLower(text))
+	if len(runes) == 0 || len(lower) != len(runes) {
+		return nil
+	}
+	for _, r := range lower {
+		if !unicode.IsLetter(r) {
+			return nil
+		}
+	}
+	if s.Morphology.HasAnalysis(string(lower)) {
+		return []string{text}
+	}
+
+	minLength, maxLength := max(s.MinWordLength, 1), s.MaxWordLength
+	if maxLength <= 0 {
+		maxLength = defaultMaxWordLength
+	}
+	accepted := make(map[string]bool)
+
+	// states[i] holds the best segmentation of the first i runes for every
+	// last word, in the order they are found
+	states := make([][]*segmentState, len(runes)+1)
+	states[0] = []*segmentState{{word: lm.DefaultSentenceBeginMarker}}
+	for end := 1; end <= len(runes); end++ {
+		byWord := make(map[string]*segmentState)
+		order := make([]string, 0)
+		for start := max(0, end-maxLength); start <= end-minLength; start++ {
+			if len(states[start]) == 0 {
+				continue
+			}
+			word := string(lower[start:end])
+			ok, known := accepted[word]
+			if !known {
+				ok = s.accepts(word)
+				accepted[word] = ok
+			}
+			if !ok {
+				continue
+			}
+			for _, prev := range states[start] {
+				score := prev.score + s.wordScore(prev.word, word)
+				best, found := byWord[word]
+				if found && best.score >= score {
+					continue
+				}
+				if !found {
+					order = append(order, word)
+				}
+				byWord[word] = &segmentState{word: word, start: start, score: score, prev: prev}
+			}
+		}
+		for _, word := range order {
+			states[end] = append(states[end], byWord[word])
+		}
+	}
+
+	var best *segmentState
+	bestScore := 0.0
+	for _, state := range states[len(runes)] {
+		score := state.score + s.wordScore(state.word, lm.DefaultSentenceEndMarker)
+		if best == nil || score > bestScore {
+			best, bestScore = state, score
+		}
+	}
+	if best == nil {
+		return nil
+	}
+
+	words := make([]string, 0)
+	for end, state := len(runes), best; state.prev != nil; end, state = state.start, state.prev {
+		words = append(words, string(runes[state.start:end]))
+	}
+	for i, j := 0, len(words)-1; i < j; i, j = i+1, j-1 {
+		words[i], words[j] = words[j], words[i]
+	}
+	return words
+}
+
+// accepts reports whether word can be a word of a segmentation
+func (s *WordSegmenter) accepts(word string) bool {
+	if s.KnownWordsOnly && s.LanguageModel != nil {
+		vocab := s.LanguageModel.GetVocabulary()
+		if vocab.IndexOf(word) == vocab.UnknownWordIndex {
+			return false
+		}
+	}
+	return s.Morphology.HasAnalysis(word)
+}
+
+// wordScore returns the log probability of word after previous, or -1
+// without a language model so that every word costs the same
+func (s *WordSegmenter) wordScore(previous, word string) float64 {
+	if s.LanguageModel == nil {
+		if word == lm.DefaultSentenceEndMarker {
+			return 0
+		}
+		return -1
+	}
+	vocab := s.LanguageModel.GetVocabulary()
+	indexes := []int{vocab.IndexOf(previous), vocab.IndexOf(word)}
+	if s.LanguageModel.GetOrder() < 2 {
+		indexes = indexes[1:]
+	}
+	return float64(s.LanguageModel.GetProbability(indexes))
+}
@@ -0,0 +1,72 @@
+package normalization
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/kalaomer/zemberek-go/lm"
+	"github.com/kalaomer/zemberek-go/morphology"
+)
+
+var (
+	segmenterMorphOnce sync.Once
+	segmenterMorph     *morphology.TurkishMorphology
+)
+
+func getSegmenterMorphology() *morphology.TurkishMorphology {
+	segmenterMorphOnce.Do(func() {
+		segmenterMorph = morphology.CreateWithDefaults()
+	})
+	return segmenterMorph
+}
+
+func TestWordSegmenter(t *testing.T) {
+	segmenter := NewWordSegmenter(getSegmenterMorphology(), nil)
+	tests := map[string]string{
+		"yarınokulagidiyorum": "yarın okula gidiyorum",
+		"YarınOkulaGidiyorum": "Yarın Okula Gidiyorum",
+		"bugünhavaçokgüzel":   "bugün hava çok güzel",
+		"gidiyorum":           "gidiyorum",
+		"xqzw":                "",
+		"okul2023":            "",
+	}
+	for text, want := range tests {
+		if got := strings.Join(segmenter.Segment(text), " "); got != want {
+			t.Errorf("Segment(%q) = %q, want %q", text, got, want)
+		}
+	}
+}
+
+func TestWordSegmenterKnownWordsOnly(t *testing.T) {
+	model := lm.NewSimpleLM(2)
+	vocab := model.GetVocabulary()
+	for i, word := range []string{lm.DefaultUnknownWord, "yarın", "okula"} {
+		vocab.Vocabulary = append(vocab.Vocabulary, word)
+		vocab.VocabularyIndexMap[word] = i
+	}
+
+	segmenter := NewWordSegmenter(getSegmenterMorphology(), model)
+	segmenter.KnownWordsOnly = true
+	if got := segmenter.Segment("yarınokula"); strings.Join(got, " ") != "yarın okula" {
+		t.Errorf("Segment(yarınokula) = %q", got)
+	}
+	if got := segmenter.Segment("yarınokulagidiyorum"); got != nil {
+		t.Errorf("Segment(yarınokulagidiyorum) = %q, want nil", got)
+	}
+}
+
+func TestSeparateWithWordSegmenter(t *testing.T) {
+	morph := getSegmenterMorphology()
+	tsn := &TurkishSentenceNormalizerAdvanced{
+		Morphology:         morph,
+		InformalMorphology: morphology.NewBuilder(morph.Lexicon).UseInformalAnalysis().IgnoreDiacriticsInAnalysis().Build(),
+		WordSegmenter:      NewWordSegmenter(morph, nil),
+	}
+	if got := tsn.separateCommon("birşeyolmaz", true); got != "birşey olmaz" {
+		t.Errorf("separateCommon(birşeyolmaz) = %q", got)
+	}
+	if got := tsn.separateCommon("birşeyolmaz", false); got != "birşeyolmaz" {
+		t.Errorf("separateCommon without lookup = %q", got)
+	}
+}
@@ -1,8 +1,13 @@
 package tokenization
 
 import (
+	"bufio"
 	_ "embed"
+	"fmt"
+	"io"
+	"sort"
 	"strings"
+	"sync"
 
 	"github.com/kalaomer/zemberek-go/core/turkish"
 )
@@ -10,51 +15,329 @@ import (
 //go:embed data/abbreviations.txt
 var abbreviationsData string
 
-// Global abbreviations set loaded from abbreviations.txt
-var abbreviations map[string]bool
+//go:embed data/abbreviations-info.txt
+var abbreviationsInfoData string
 
-func init() {
-	abbreviations = loadAbbreviations()
+//go:embed data/abbreviations-legal.txt
+var legalAbbreviationsData string
+
+// LegalDomain is the domain of the built-in legal abbreviation list, with
+// court decision abbreviations like "E.", "K.", "md." and "Yarg."
+const LegalDomain = "legal"
+
+// builtinDomains holds the embedded domain lists by domain
+var builtinDomains = map[string]*string{
+	LegalDomain: &legalAbbreviationsData,
+}
+
+// AbbreviationInfo holds what is known about an abbreviation
+type AbbreviationInfo struct {
+	// Form is the abbreviation as written, like "Dr." or "TBMM"
+	Form string
+	// Expansion is the long form, like "doktor" for "Dr."
+	Expansion string
+	// Pronunciation is how the abbreviation is read when suffixes are
+	// attached, like "doktor" for "Dr." or "tebeemem" for "TBMM"
+	Pronunciation string
+	// EndsSentence tells whether the abbreviation can end a sentence. "vs."
+	// can, titles like "Dr." and "Prof." cannot.
+	EndsSentence bool
+	// Domain is the list the abbreviation comes from, empty for general ones
+	Domain string
+
+	// lexicon marks entries read from a lexicon dictionary, which are not
+	// written with a dot
+	lexicon bool
+}
+
+// AbbreviationRegistry holds abbreviations from several sources: the
+// general list embedded in the package, domain lists and lexicon
+// dictionaries. Lookups are case-insensitive with Turkish casing. A
+// registry is safe for concurrent use.
+type AbbreviationRegistry struct {
+	mu    sync.RWMutex
+	forms map[string]*AbbreviationInfo
+	lower map[string]*AbbreviationInfo
+	// undotted holds the lower case forms of the listed abbreviations
+	// without their final dot, lexicon entries excluded
+	undotted map[string]bool
+}
+
+// DefaultAbbreviations is the registry the tokenizer and the sentence
+// extractor use when they are not given one. It holds the general list
+// embedded in the package and should be treated as read-only: to add domain
+// lists, create a registry with NewDefaultAbbreviationRegistry and pass it
+// to TokenizerBuilder.Abbreviations and TurkishSentenceExtractor.
+var DefaultAbbreviations = NewDefaultAbbreviationRegistry()
+
+// NewAbbreviationRegistry creates an empty registry
+func NewAbbreviationRegistry() *AbbreviationRegistry {
+	return &AbbreviationRegistry{
+		forms:    make(map[string]*AbbreviationInfo),
+		lower:    make(map[string]*AbbreviationInfo),
+		undotted: make(map[string]bool),
+	}
+}
+
+// NewDefaultAbbreviationRegistry creates a registry with the general
+// abbreviations embedded in the package
+func NewDefaultAbbreviationRegistry() *AbbreviationRegistry {
+	r := NewAbbreviationRegistry()
+	for _, data := range []string{abbreviationsData, abbreviationsInfoData} {
+		if err := r.Read(strings.NewReader(data), ""); err != nil {
+			panic("tokenization: embedded abbreviations: " + err.Error())
+		}
+	}
+	return r
+}
+
+// Add adds a to the registry. If a.Form is already known, its expansion
+// and pronunciation are replaced when a has them and EndsSentence is
+// replaced.
+func (r *AbbreviationRegistry) Add(a AbbreviationInfo) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.add(&a)
+}
+
+func (r *AbbreviationRegistry) add(a *AbbreviationInfo) {
+	if known, ok := r.forms[a.Form]; ok {
+		if a.Expansion != "" {
+			known.Expansion = a.Expansion
+		}
+		if a.Pronunciation != "" {
+			known.Pronunciation = a.Pronunciation
+		}
+		if a.Domain != "" {
+			known.Domain = a.Domain
+		}
+		known.EndsSentence = a.EndsSentence
+		return
+	}
+	r.forms[a.Form] = a
+	if !a.lexicon {
+		r.undotted[turkishLower(strings.TrimSuffix(a.Form, "."))] = true
+	}
+	lower := turkish.Instance.ToLower(a.Form)
+	if _, ok := r.lower[lower]; !ok {
+		r.lower[lower] = a
+	}
 }
 
-// loadAbbreviations loads abbreviations from embedded file
-// Matches Java implementation:
-// - Only loads abbreviations that end with dot (Prof., Dr., etc.)
-// - Adds lowercase versions (English and Turkish)
-func loadAbbreviations() map[string]bool {
-	abbr := make(map[string]bool)
+// Read adds the abbreviations of a list read from r under domain. Every
+// line holds a form and optionally its expansion, pronunciation and
+// options separated by tabs, like
+//
+//	Dr.	doktor	doktor	noend
+//
+// The "noend" option marks abbreviations that cannot end a sentence.
+// Spaces inside forms are removed, "Arş. Gör." is "Arş.Gör.". Empty lines
+// and lines starting with '#' are skipped.
+func (r *AbbreviationRegistry) Read(reader io.Reader, domain string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
 
-	lines := strings.Split(abbreviationsData, "\n")
-	for _, line := range lines {
-		line = strings.TrimSpace(line)
-		if line == "" {
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		for len(fields) < 4 {
+			fields = append(fields, "")
+		}
+		form := strings.ReplaceAll(strings.TrimSpace(fields[0]), " ", "")
+		if form == "" {
 			continue
 		}
+		r.add(&AbbreviationInfo{
+			Form:          form,
+			Expansion:     strings.TrimSpace(fields[1]),
+			Pronunciation: strings.TrimSpace(fields[2]),
+			EndsSentence:  !strings.Contains(fields[3], "noend"),
+			Domain:        domain,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("tokenization: read abbreviations: %w", err)
+	}
+	return nil
+}
 
-		// Remove any spaces from abbreviation
-		line = strings.ReplaceAll(line, " ", "")
+// AddDomain adds a domain list embedded in the package, like LegalDomain
+func (r *AbbreviationRegistry) AddDomain(domain string) error {
+	data, ok := builtinDomains[domain]
+	if !ok {
+		return fmt.Errorf("tokenization: unknown abbreviation domain %q", domain)
+	}
+	return r.Read(strings.NewReader(*data), domain)
+}
 
-		// Only process abbreviations that end with dot (matches Java behavior)
-		if strings.HasSuffix(line, ".") {
-			// Add as-is
-			abbr[line] = true
+// ReadDictionary adds the pronunciations and expansions of a lexicon
+// dictionary read from r, with lines like "Dr [Pr:doktor;Ref:doktor;
+// P:Abbrv]". An entry also fills in the abbreviation written with a dot,
+// "Dr." here. Dictionary forms have no dot, so they are not used to keep
+// dots in tokens or to decide sentence boundaries.
+func (r *AbbreviationRegistry) ReadDictionary(reader io.Reader) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
 
-			// Add Turkish lowercase
-			abbr[turkish.Instance.ToLower(line)] = true
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "##") {
+			continue
+		}
+		form, attributes, _ := strings.Cut(line, "[")
+		form = strings.TrimSpace(form)
+		if form == "" {
+			continue
 		}
+		a := &AbbreviationInfo{Form: form, EndsSentence: true, lexicon: true}
+		for _, attribute := range strings.Split(strings.TrimSuffix(strings.TrimSpace(attributes), "]"), ";") {
+			key, value, _ := strings.Cut(strings.TrimSpace(attribute), ":")
+			switch strings.ToLower(key) {
+			case "pr":
+				a.Pronunciation = strings.TrimSpace(value)
+			case "ref":
+				a.Expansion = strings.TrimSpace(value)
+			}
+		}
+
+		if dotted, ok := r.forms[form+"."]; ok {
+			if dotted.Expansion == "" {
+				dotted.Expansion = a.Expansion
+			}
+			if dotted.Pronunciation == "" {
+				dotted.Pronunciation = a.Pronunciation
+			}
+		}
+		if known, ok := r.forms[form]; ok {
+			if a.Expansion != "" {
+				known.Expansion = a.Expansion
+			}
+			if a.Pronunciation != "" {
+				known.Pronunciation = a.Pronunciation
+			}
+			continue
+		}
+		r.add(a)
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("tokenization: read abbreviation dictionary: %w", err)
+	}
+	return nil
+}
+
+// Lookup returns the abbreviation written as word, matching it as is
+// first and then in lower case
+func (r *AbbreviationRegistry) Lookup(word string) (AbbreviationInfo, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if a, ok := r.forms[word]; ok {
+		return *a, true
 	}
+	if a, ok := r.lower[turkish.Instance.ToLower(word)]; ok {
+		return *a, true
+	}
+	return AbbreviationInfo{}, false
+}
+
+// exact returns the abbreviation written exactly as word, lexicon entries
+// excluded
+func (r *AbbreviationRegistry) exact(word string) (AbbreviationInfo, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	a, ok := r.forms[word]
+	if !ok || a.lexicon {
+		return AbbreviationInfo{}, false
+	}
+	return *a, true
+}
+
+// listed reports whether word is a listed abbreviation without its final
+// dot, in any casing. Lexicon entries are not listed.
+func (r *AbbreviationRegistry) listed(word string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.undotted[turkishLower(word)]
+}
+
+// IsAbbreviation reports whether word is a known abbreviation. Lexicon
+// entries count only when written without a dot.
+func (r *AbbreviationRegistry) IsAbbreviation(word string) bool {
+	_, ok := r.Lookup(word)
+	return ok
+}
 
-	return abbr
+// CanEndSentence reports whether word is an abbreviation that can end a
+// sentence. Words that are not abbreviations can.
+func (r *AbbreviationRegistry) CanEndSentence(word string) bool {
+	a, ok := r.Lookup(word)
+	return !ok || a.EndsSentence
 }
 
-// IsAbbreviation checks if a word is a known abbreviation
-// Case-insensitive check (Prof., prof., PROF. all match)
+// Expand returns the expansion of the abbreviation word, or "" if it has
+// none
+func (r *AbbreviationRegistry) Expand(word string) string {
+	a, _ := r.Lookup(word)
+	return a.Expansion
+}
+
+// Pronunciation returns the pronunciation of the abbreviation word, or ""
+// if it has none
+func (r *AbbreviationRegistry) Pronunciation(word string) string {
+	a, _ := r.Lookup(word)
+	return a.Pronunciation
+}
+
+// Pronunciations returns the known pronunciations by form, in the shape of
+// suffix.Attacher's Pronunciations
+func (r *AbbreviationRegistry) Pronunciations() map[string]string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	pronunciations := make(map[string]string)
+	for form, a := range r.forms {
+		if a.Pronunciation != "" {
+			pronunciations[form] = a.Pronunciation
+		}
+	}
+	return pronunciations
+}
+
+// Abbreviations returns all abbreviations sorted by form
+func (r *AbbreviationRegistry) Abbreviations() []AbbreviationInfo {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	all := make([]AbbreviationInfo, 0, len(r.forms))
+	for _, a := range r.forms {
+		all = append(all, *a)
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].Form < all[j].Form })
+	return all
+}
+
+// Set returns the written abbreviations without their final dot and in
+// lower case too
+func (r *AbbreviationRegistry) Set() map[string]bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	set := make(map[string]bool)
+	for form, a := range r.forms {
+		if a.lexicon {
+			continue
+		}
+		form = strings.TrimSuffix(form, ".")
+		set[form] = true
+		set[turkishLower(form)] = true
+	}
+	return set
+}
+
+// IsAbbreviation checks if a word is an abbreviation of DefaultAbbreviations
+// written with a dot. Case-insensitive check (Prof., prof., PROF. all match)
 // Example: IsAbbreviation("Prof.") -> true
 func IsAbbreviation(word string) bool {
-	// Check as-is first (fast path)
-	if abbreviations[word] {
-		return true
-	}
-	// Check Turkish lowercase version
-	return abbreviations[turkish.Instance.ToLower(word)]
+	return strings.HasSuffix(word, ".") && DefaultAbbreviations.IsAbbreviation(word)
 }
@@ -0,0 +1,137 @@
+package tokenization
+
+import (
+	"errors"
+	"io/fs"
+	"slices"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestAbbreviationRegistry(t *testing.T) {
+	r := NewDefaultAbbreviationRegistry()
+
+	a, ok := r.Lookup("DR.")
+	if !ok || a.Form != "Dr." || a.Expansion != "doktor" || a.EndsSentence {
+		t.Errorf("Lookup(DR.) = %+v, %v", a, ok)
+	}
+	if r.CanEndSentence("Prof.") {
+		t.Error("Prof. should not end a sentence")
+	}
+	if !r.CanEndSentence("vs.") {
+		t.Error("vs. should end a sentence")
+	}
+	if got := r.Expand("bkz."); got != "bakınız" {
+		t.Errorf("Expand(bkz.) = %q", got)
+	}
+	if r.IsAbbreviation("Yarg.") {
+		t.Error("Yarg. is not a general abbreviation")
+	}
+
+	if err := r.AddDomain(LegalDomain); err != nil {
+		t.Fatal(err)
+	}
+	a, ok = r.Lookup("Yarg.")
+	if !ok || a.Domain != LegalDomain || a.Pronunciation != "yargıtay" {
+		t.Errorf("Lookup(Yarg.) = %+v, %v", a, ok)
+	}
+	if err := r.AddDomain("medical"); err == nil {
+		t.Error("unknown domain should fail")
+	}
+
+	r.Add(AbbreviationInfo{Form: "Ltd.Şti.", Expansion: "limitet şirketi"})
+	if !r.IsAbbreviation("ltd.şti.") || r.Expand("Ltd.Şti.") != "limitet şirketi" {
+		t.Error("added abbreviation not found")
+	}
+}
+
+func TestReadAbbreviationDictionary(t *testing.T) {
+	r := NewAbbreviationRegistry()
+	if err := r.Read(strings.NewReader("Dr.\n"), ""); err != nil {
+		t.Fatal(err)
+	}
+	dict := "## comment\nDr [Pr:doktor;Ref:doktor; P:Abbrv]\nTBMM [Pr:tebeemem; P:Abbrv]\n"
+	if err := r.ReadDictionary(strings.NewReader(dict)); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := r.Pronunciation("Dr."); got != "doktor" {
+		t.Errorf("Pronunciation(Dr.) = %q", got)
+	}
+	if got := r.Pronunciations()["TBMM"]; got != "tebeemem" {
+		t.Errorf("Pronunciations()[TBMM] = %q", got)
+	}
+	// dictionary forms have no dot and are not used for boundaries
+	if set := r.Set(); set["TBMM"] || !set["Dr"] {
+		t.Errorf("Set() = %v", set)
+	}
+}
+
+func TestLegalAbbreviationsInSentences(t *testing.T) {
+	extractor, err := NewTurkishSentenceExtractor(false, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	extractor.Abbreviations = NewDefaultAbbreviationRegistry()
+	if err := extractor.Abbreviations.AddDomain(LegalDomain); err != nil {
+		t.Fatal(err)
+	}
+
+	paragraph := "Suç TCK. md. 5 f. 2 uyarınca işlendi. Dosya Dr. Ahmet Bey'e verildi."
+	want := []string{"Suç TCK. md. 5 f. 2 uyarınca işlendi.", "Dosya Dr. Ahmet Bey'e verildi."}
+	got := extractor.FromParagraph(paragraph)
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("FromParagraph(%q) = %q, want %q", paragraph, got, want)
+	}
+}
+
+func TestTokenizerAbbreviations(t *testing.T) {
+	registry := NewDefaultAbbreviationRegistry()
+	if err := registry.AddDomain(LegalDomain); err != nil {
+		t.Fatal(err)
+	}
+	legal := NewBuilder().AcceptAll().Abbreviations(registry).Build()
+	if got := legal.TokenizeToStrings("Yarg. kararı"); strings.Join(got, "|") != "Yarg.| |kararı" {
+		t.Errorf("with the legal domain got %q", got)
+	}
+	if got := NewBuilder().AcceptAll().Build().TokenizeToStrings("Yarg. kararı"); strings.Join(got, "|") != "Yarg|.| |kararı" {
+		t.Errorf("without the legal domain got %q", got)
+	}
+
+	// domains added after construction are used by the extractor too
+	extractor, err := NewTurkishSentenceExtractor(false, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	extractor.Abbreviations = NewDefaultAbbreviationRegistry()
+	data := boundaryData{leftChunkUntilBoundary: "Yarg", currentChar: '.'}
+	if slices.Contains(data.features(extractor.Abbreviations), "15:true") {
+		t.Errorf("Yarg is listed before the legal domain is added")
+	}
+	if err := extractor.Abbreviations.AddDomain(LegalDomain); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Contains(data.features(extractor.Abbreviations), "15:true") {
+		t.Errorf("Yarg is not listed after the legal domain is added")
+	}
+}
+
+func TestSentenceExtractorFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"sentence-boundary/weights.csv":       {Data: []byte(defaultWeightsData)},
+		"sentence-boundary/abbreviations.txt": {Data: []byte("Yarg.\tYargıtay\t\tnoend\n")},
+	}
+	extractor, err := NewTurkishSentenceExtractorFS(false, fsys, "sentence-boundary/weights.csv", "sentence-boundary/abbreviations.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !extractor.Abbreviations.IsAbbreviation("Yarg.") || extractor.Abbreviations.IsAbbreviation("Dr.") {
+		t.Errorf("abbreviations are not read from the bundle: %v", extractor.Abbreviations.Abbreviations())
+	}
+
+	delete(fsys, "sentence-boundary/abbreviations.txt")
+	if _, err := NewTurkishSentenceExtractorFS(false, fsys, "sentence-boundary/weights.csv", "sentence-boundary/abbreviations.txt"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("expected a missing abbreviations error, got %v", err)
+	}
+}
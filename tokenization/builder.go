@@ -4,6 +4,8 @@ package tokenization
 // Matches Java's TurkishTokenizer.Builder pattern
 type TokenizerBuilder struct {
 	acceptedTypes map[TokenType]bool
+	segmenter     WordSegmenter
+	abbreviations *AbbreviationRegistry
 }
 
 // NewBuilder creates a new tokenizer builder
@@ -16,7 +18,7 @@ func NewBuilder() *TokenizerBuilder {
 // AcceptAll marks all token types as accepted
 func (b *TokenizerBuilder) AcceptAll() *TokenizerBuilder {
 	// Add all token types
-	for i := SpaceTab; i <= Unknown; i++ {
+	for i := SpaceTab; i <= lastTokenType; i++ {
 		b.acceptedTypes[i] = true
 	}
 	return b
@@ -44,6 +46,23 @@ func (b *TokenizerBuilder) IgnoreTypes(types ...TokenType) *TokenizerBuilder {
 	return b
 }
 
+// SegmentHashtags makes the tokenizer split the bodies of HashTag tokens
+// into words with segmenter, e.g. "#yarınokulagidiyorum" into "yarın",
+// "okula" and "gidiyorum". The words are stored in the normalized form of
+// the token.
+func (b *TokenizerBuilder) SegmentHashtags(segmenter WordSegmenter) *TokenizerBuilder {
+	b.segmenter = segmenter
+	return b
+}
+
+// Abbreviations makes the tokenizer keep the dots of the abbreviations of
+// registry, like the legal "md." of a registry with LegalDomain added.
+// DefaultAbbreviations is used otherwise.
+func (b *TokenizerBuilder) Abbreviations(registry *AbbreviationRegistry) *TokenizerBuilder {
+	b.abbreviations = registry
+	return b
+}
+
 // Build constructs the TurkishTokenizer with configured settings
 func (b *TokenizerBuilder) Build() *TurkishTokenizer {
 	// Copy the accepted types map to avoid sharing state
@@ -54,5 +73,7 @@ func (b *TokenizerBuilder) Build() *TurkishTokenizer {
 
 	return &TurkishTokenizer{
 		acceptedTypes: acceptedTypes,
+		segmenter:     b.segmenter,
+		abbreviations: b.abbreviations,
 	}
 }
@@ -21,14 +21,16 @@ import (
 // 9. Percent (%100)
 // 10. Number (various formats)
 // 11. Emoticon
-// 12. Roman Numeral
-// 13. Abbreviation with dots (I.B.M.)
-// 14. Word with symbol (F-16)
-// 15. Word alphanumerical (F16)
-// 16. Word
-// 17. Punctuation
-// 18. Unknown word
-// 19. Unknown
+// 12. Emoji
+// 13. Roman Numeral
+// 14. Abbreviation with dots (I.B.M.)
+// 15. Word with symbol (F-16)
+// 16. Word alphanumerical (F16)
+// 17. Word
+// 18. Punctuation
+// 19. Symbol
+// 20. Unknown word
+// 21. Unknown
 func DetermineTokenType(word string) TokenType {
 	if word == "" {
 		return Unknown
@@ -82,12 +84,18 @@ func DetermineTokenType(word string) TokenType {
 		return Emoticon
 	}
 
-	// 10. Percent
+	// 10. Emoji (before Number - keycaps like "1️⃣" start with digits)
+	l := &lexer{runes: []rune(word)}
+	if l.emoji(0) == len(l.runes) {
+		return Emoji
+	}
+
+	// 11. Percent
 	if percentPattern.MatchString(word) {
 		return PercentNumeral
 	}
 
-	// 11. Number (check specific patterns in order)
+	// 12. Number (check specific patterns in order)
 	// Order matters: most specific patterns first
 	if numberExpPattern.MatchString(word) {
 		return Number
@@ -111,22 +119,22 @@ func DetermineTokenType(word string) TokenType {
 		return Number
 	}
 
-	// 12. Abbreviation with dots (before RomanNumeral - more specific)
+	// 13. Abbreviation with dots (before RomanNumeral - more specific)
 	if abbreviationWithDotsPattern.MatchString(word) {
 		return AbbreviationWithDots
 	}
 
-	// 13. Roman Numeral
+	// 14. Roman Numeral
 	if romanNumeralPattern.MatchString(word) {
 		return RomanNumeral
 	}
 
-	// 14. Word with symbol (F-16'yı)
+	// 15. Word with symbol (F-16'yı)
 	if wordWithSymbolPattern.MatchString(word) {
 		return WordWithSymbol
 	}
 
-	// 15. Word alphanumerical (F16, H1N1)
+	// 16. Word alphanumerical (F16, H1N1)
 	// Must check if contains both letters and digits
 	hasLetter := false
 	hasDigit := false
@@ -143,22 +151,27 @@ func DetermineTokenType(word string) TokenType {
 		return WordAlphanumerical
 	}
 
-	// 16. Pure word (Turkish letters only)
+	// 17. Pure word (Turkish letters only)
 	if wordPattern.MatchString(word) {
 		return Word
 	}
 
-	// 17. Punctuation
+	// 18. Punctuation
 	if punctuationPattern.MatchString(word) {
 		return Punctuation
 	}
 
-	// 18. Unknown word (some characters but not matching other patterns)
+	// 19. Symbol (€, →, ★)
+	if l.symbol(0) == len(l.runes) {
+		return Symbol
+	}
+
+	// 20. Unknown word (some characters but not matching other patterns)
 	if unknownWordPattern.MatchString(word) {
 		return UnknownWord
 	}
 
-	// 19. Unknown (default)
+	// 21. Unknown (default)
 	return Unknown
 }
 
@@ -168,6 +181,43 @@ func NormalizeApostrophe(text string) string {
 	return strings.ReplaceAll(text, "'", "'")
 }
 
+// composedLetters maps letters followed by a combining mark to the
+// precomposed letters of the Turkish alphabet
+var composedLetters = map[[2]rune]rune{
+	{'c', '\u0327'}: 'ç', {'C', '\u0327'}: 'Ç',
+	{'s', '\u0327'}: 'ş', {'S', '\u0327'}: 'Ş',
+	{'g', '\u0306'}: 'ğ', {'G', '\u0306'}: 'Ğ',
+	{'o', '\u0308'}: 'ö', {'O', '\u0308'}: 'Ö',
+	{'u', '\u0308'}: 'ü', {'U', '\u0308'}: 'Ü',
+	{'I', '\u0307'}: 'İ', {'i', '\u0307'}: 'i',
+	{'a', '\u0302'}: 'â', {'A', '\u0302'}: 'Â',
+	{'i', '\u0302'}: 'î', {'I', '\u0302'}: 'Î',
+	{'u', '\u0302'}: 'û', {'U', '\u0302'}: 'Û',
+}
+
+// NormalizeMarks composes Turkish letters written with combining marks and
+// removes variation selectors. "i̇" written as "i" and U+0307, as lowercasing
+// "İ" outside Turkish locales produces, becomes "i".
+func NormalizeMarks(text string) string {
+	if !strings.ContainsFunc(text, isMark) {
+		return text
+	}
+	runes := make([]rune, 0, len(text))
+	for _, r := range text {
+		if n := len(runes); n > 0 {
+			if composed, ok := composedLetters[[2]rune{runes[n-1], r}]; ok {
+				runes[n-1] = composed
+				continue
+			}
+		}
+		if r >= 0xfe00 && r <= 0xfe0f {
+			continue
+		}
+		runes = append(runes, r)
+	}
+	return string(runes)
+}
+
 // stripTurkishSuffix removes Turkish suffix from token if present.
 // Returns the base form without suffix.
 // Example: "100'e" -> "100", "www.foo.com'da" -> "www.foo.com"
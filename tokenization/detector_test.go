@@ -538,3 +538,28 @@ func TestEdgeCases(t *testing.T) {
 		t.Errorf("DetermineTokenType(%q) = %v, want UnknownWord or Word", arabicInput, TokenTypeName(got))
 	}
 }
+
+// TestNumberWords tests reading number tokens in words
+func TestNumberWords(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		ok       bool
+	}{
+		{"1234", "bin iki yüz otuz dört", true},
+		{"100'e", "yüz", true},
+		{"24,30", "yirmi dört virgül otuz", true},
+		{"3.", "üçüncü", true},
+		{"%40'a", "yüzde kırk", true},
+		{"1/2", "", false},
+		{"kitap", "", false},
+	}
+
+	for _, tt := range tests {
+		token := NewToken(tt.input, DetermineTokenType(tt.input), 0, len(tt.input))
+		got, ok := token.NumberWords()
+		if got != tt.expected || ok != tt.ok {
+			t.Errorf("NumberWords(%q) = %q, %v, want %q, %v", tt.input, got, ok, tt.expected, tt.ok)
+		}
+	}
+}
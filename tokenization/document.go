@@ -0,0 +1,338 @@
+package tokenization
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// BlockType represents the type of a block of a document
+type BlockType int
+
+const (
+	ParagraphBlock BlockType = iota + 1
+	HeadingBlock
+	ListItemBlock
+	CitationBlock
+	FieldBlock
+)
+
+// BlockTypeName returns the name of a block type
+func BlockTypeName(t BlockType) string {
+	switch t {
+	case ParagraphBlock:
+		return "Paragraph"
+	case HeadingBlock:
+		return "Heading"
+	case ListItemBlock:
+		return "ListItem"
+	case CitationBlock:
+		return "Citation"
+	case FieldBlock:
+		return "Field"
+	default:
+		return "Unknown"
+	}
+}
+
+var (
+	// listMarkerRegex matches enumerations like "1.", "2)", "3-", "a)",
+	// "(b)", "IV.", "(I)" and bullets at the start of a line
+	listMarkerRegex = regexp.MustCompile(`^(?:\((?:[0-9]+|[IVXLCDM]+|[ivxlcdm]+|\p{Ll})\)|(?:[0-9]+|[IVXLCDM]+)[.)-]|\p{Ll}\)|[-–•*])\s+`)
+	// esasRegex, kararRegex and docketRegex match court decision references
+	// like "2014/4087 E., 2014/3970 K." and "Esas No: 2014/4087"
+	esasRegex    = regexp.MustCompile(`\d{4}/\d+\s*(?:E|Esas)\b`)
+	kararRegex   = regexp.MustCompile(`\d{4}/\d+\s*(?:K|Karar)\b`)
+	docketRegex  = regexp.MustCompile(`^(?i:esas|karar)(?:\s+(?i:no|numarası))?\s*[:.]?\s*\d{4}/\d+`)
+	fieldRegex   = regexp.MustCompile(`^\p{Lu}[\p{Lu}\p{M} ]*\p{Lu}\p{M}*\s*:\s*\S`)
+	romanNumeral = map[rune]int{'I': 1, 'V': 5, 'X': 10, 'L': 50, 'C': 100, 'D': 500, 'M': 1000}
+)
+
+// maxHeadingWords is the number of words a heading can have
+const maxHeadingWords = 12
+
+// Document is a text segmented into sections, blocks and sentences. All
+// offsets are in the segmented text.
+type Document struct {
+	Sections []*Section
+}
+
+// Section is a heading and the blocks up to the next heading. The first
+// section has no heading if the text does not start with one.
+type Section struct {
+	Heading *Block
+	Blocks  []*Block
+	Span
+}
+
+// Block is a paragraph, heading, list item, citation or field line. Marker
+// holds the enumeration of a list item or the label of a field, sentences
+// are extracted from the text after it.
+type Block struct {
+	Type      BlockType
+	Text      string
+	Marker    string
+	Sentences []*Sentence
+	Span
+
+	// contentStart is the rune offset after the marker
+	contentStart int
+}
+
+// Sentences returns the sentences of all blocks of the document in order,
+// headings included
+func (d *Document) Sentences() []*Sentence {
+	sentences := make([]*Sentence, 0)
+	for _, section := range d.Sections {
+		if section.Heading != nil {
+			sentences = append(sentences, section.Heading.Sentences...)
+		}
+		for _, block := range section.Blocks {
+			sentences = append(sentences, block.Sentences...)
+		}
+	}
+	return sentences
+}
+
+// DocumentSegmenter splits documents like court decisions into sections,
+// paragraphs and sentences. Blank lines separate paragraphs, wrapped lines
+// are joined. Headings, enumerations, citation lines and "LABEL: value"
+// fields start blocks of their own, so they never merge into neighbouring
+// sentences.
+type DocumentSegmenter struct {
+	Extractor *TurkishSentenceExtractor
+}
+
+// NewDocumentSegmenter creates a document segmenter that splits blocks into
+// sentences with extractor
+func NewDocumentSegmenter(extractor *TurkishSentenceExtractor) *DocumentSegmenter {
+	return &DocumentSegmenter{Extractor: extractor}
+}
+
+// line is a line of a document in runes, without the line break and
+// surrounding spaces
+type line struct {
+	start, end int
+}
+
+func (l line) blank() bool {
+	return l.start == l.end
+}
+
+// Segment segments text into a document
+func (d *DocumentSegmenter) Segment(text string) *Document {
+	runes := []rune(text)
+	offsets := NewOffsetMap(text)
+	lines := splitLines(runes)
+
+	doc := &Document{Sections: make([]*Section, 0)}
+	var section *Section
+	var current *Block
+	// number is the value of the last numbered list item, 0 if the last
+	// block is not one
+	number := 0
+
+	add := func(block *Block) {
+		if section == nil || block.Type == HeadingBlock {
+			section = &Section{Blocks: make([]*Block, 0)}
+			doc.Sections = append(doc.Sections, section)
+		}
+		if block.Type == HeadingBlock {
+			section.Heading = block
+		} else {
+			section.Blocks = append(section.Blocks, block)
+		}
+	}
+
+	for i, l := range lines {
+		if l.blank() {
+			current = nil
+			continue
+		}
+		s := string(runes[l.start:l.end])
+		standalone := (i == 0 || lines[i-1].blank()) && (i == len(lines)-1 || lines[i+1].blank())
+
+		blockType := ParagraphBlock
+		markerEnd := 0
+		value := 0
+		switch {
+		case isCitation(s):
+			blockType = CitationBlock
+		case fieldRegex.MatchString(s):
+			blockType = FieldBlock
+			markerEnd = len([]rune(s[:strings.IndexRune(s, ':')+1]))
+		default:
+			if m := listMarkerRegex.FindString(s); m != "" {
+				value = markerValue(strings.TrimSpace(m))
+				if value == 0 || value == 1 || value == number+1 {
+					blockType = ListItemBlock
+					markerEnd = len([]rune(m))
+				}
+			}
+			if isHeading(string(runes[l.start+markerEnd:l.end]), standalone) {
+				blockType = HeadingBlock
+			}
+		}
+		if blockType != ListItemBlock {
+			value = 0
+		}
+
+		if blockType == ParagraphBlock && current != nil {
+			// a wrapped line continues the block
+			current.End = l.end
+			continue
+		}
+		current = &Block{
+			Type:         blockType,
+			Marker:       strings.TrimSpace(string(runes[l.start : l.start+markerEnd])),
+			Span:         Span{Start: l.start, End: l.end},
+			contentStart: l.start + markerEnd,
+		}
+		if blockType != ListItemBlock || value != 0 {
+			number = value
+		}
+		add(current)
+		if blockType == HeadingBlock || blockType == CitationBlock {
+			current = nil
+		}
+	}
+
+	for _, section := range doc.Sections {
+		blocks := section.Blocks
+		if section.Heading != nil {
+			blocks = append([]*Block{section.Heading}, blocks...)
+		}
+		for _, block := range blocks {
+			d.finish(block, runes, offsets)
+		}
+		first, last := blocks[0], blocks[len(blocks)-1]
+		section.Span = *offsets.Span(first.Start, last.End)
+	}
+	return doc
+}
+
+// finish sets the text, offsets and sentences of a block
+func (d *DocumentSegmenter) finish(block *Block, runes []rune, offsets *OffsetMap) {
+	contentStart := block.contentStart
+	for contentStart < block.End && unicode.IsSpace(runes[contentStart]) {
+		contentStart++
+	}
+	block.Span = *offsets.Span(block.Start, block.End)
+	block.Text = string(runes[block.Start:block.End])
+	block.Sentences = make([]*Sentence, 0)
+
+	if block.Type == HeadingBlock || block.Type == CitationBlock || d.Extractor == nil {
+		block.Sentences = appendSentence(block.Sentences, runes, offsets, contentStart, block.End)
+		return
+	}
+	for _, span := range d.Extractor.ExtractToSpans(string(runes[contentStart:block.End])) {
+		block.Sentences = appendSentence(block.Sentences, runes, offsets, contentStart+span.Start, contentStart+span.End)
+	}
+}
+
+// appendSentence appends the runes from start to end without surrounding
+// spaces as a sentence, unless there are only spaces
+func appendSentence(sentences []*Sentence, runes []rune, offsets *OffsetMap, start, end int) []*Sentence {
+	for start < end && unicode.IsSpace(runes[start]) {
+		start++
+	}
+	for end > start && unicode.IsSpace(runes[end-1]) {
+		end--
+	}
+	if start == end {
+		return sentences
+	}
+	return append(sentences, &Sentence{
+		Text: string(runes[start:end]),
+		Span: *offsets.Span(start, end),
+	})
+}
+
+// splitLines splits runes at line breaks and trims the lines
+func splitLines(runes []rune) []line {
+	lines := make([]line, 0)
+	start := 0
+	for i := 0; i <= len(runes); i++ {
+		if i < len(runes) && runes[i] != '\n' {
+			continue
+		}
+		l := line{start: start, end: i}
+		for l.start < l.end && unicode.IsSpace(runes[l.start]) {
+			l.start++
+		}
+		for l.end > l.start && unicode.IsSpace(runes[l.end-1]) {
+			l.end--
+		}
+		lines = append(lines, l)
+		start = i + 1
+	}
+	return lines
+}
+
+// isCitation reports whether s is a court decision reference line
+func isCitation(s string) bool {
+	return esasRegex.MatchString(s) && kararRegex.MatchString(s) && len(strings.Fields(s)) <= maxHeadingWords ||
+		docketRegex.MatchString(s)
+}
+
+// isHeading reports whether s is a heading: a short line in capitals like
+// "KARAR", or a short line like "İçtihat Metni" standing between blank
+// lines that starts with a capital and does not end like a sentence
+func isHeading(s string, standalone bool) bool {
+	words := strings.Fields(s)
+	if len(words) == 0 || len(words) > maxHeadingWords {
+		return false
+	}
+	letters, upper := 0, 0
+	for _, r := range s {
+		if unicode.IsLetter(r) {
+			letters++
+			if unicode.IsUpper(r) {
+				upper++
+			}
+		}
+	}
+	if letters >= 2 && upper == letters {
+		return !strings.HasSuffix(s, ",") && !strings.HasSuffix(s, ";")
+	}
+	if !standalone || len(words) > 6 || !startsUpper(s) {
+		return false
+	}
+	last := []rune(s)[len([]rune(s))-1]
+	return unicode.IsLetter(last) || unicode.IsDigit(last)
+}
+
+// markerValue returns the value of a numbered list marker like "2." or
+// "IV.", or 0 for other markers
+func markerValue(marker string) int {
+	if !strings.HasSuffix(marker, ".") {
+		return 0
+	}
+	marker = strings.TrimSuffix(marker, ".")
+	value := 0
+	for _, r := range marker {
+		if r < '0' || r > '9' {
+			return romanValue(marker)
+		}
+		value = value*10 + int(r-'0')
+		if value > 10000 {
+			return value
+		}
+	}
+	return value
+}
+
+// romanValue returns the value of a Roman numeral in capitals
+func romanValue(s string) int {
+	value := 0
+	runes := []rune(s)
+	for i, r := range runes {
+		v := romanNumeral[r]
+		if i+1 < len(runes) && v < romanNumeral[runes[i+1]] {
+			value -= v
+		} else {
+			value += v
+		}
+	}
+	return value
+}
@@ -0,0 +1,115 @@
+package tokenization
+
+import "testing"
+
+const legalDocument = `13. Hukuk Dairesi 2014/4087 E., 2014/3970 K.
+
+İçtihat Metni
+
+MAHKEMESİ: Tüketici Mahkemesi
+
+Taraflar arasındaki alacak davasının yapılan yargılaması sonunda davanın kabulüne
+yönelik olarak verilen hüküm davalı avukatınca temyiz edildi. Dosya incelendi.
+
+KARAR
+1. Davalının temyiz itirazlarının reddine,
+2. Hükmün ONANMASINA,
+a) Harcın iadesine,
+(I) 17.02.2014 gününde oybirliğiyle karar verildi.
+19. yüzyıldan kalma bir kural da uygulandı.`
+
+func TestDocumentSegmenter(t *testing.T) {
+	extractor, err := NewTurkishSentenceExtractor(false, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc := NewDocumentSegmenter(extractor).Segment(legalDocument)
+
+	type block struct {
+		typ    BlockType
+		marker string
+		text   string
+	}
+	want := [][]block{
+		{
+			{CitationBlock, "", "13. Hukuk Dairesi 2014/4087 E., 2014/3970 K."},
+		},
+		{
+			{HeadingBlock, "", "İçtihat Metni"},
+			{FieldBlock, "MAHKEMESİ:", "MAHKEMESİ: Tüketici Mahkemesi"},
+			{ParagraphBlock, "", "Taraflar arasındaki alacak davasının yapılan yargılaması sonunda davanın kabulüne\nyönelik olarak verilen hüküm davalı avukatınca temyiz edildi. Dosya incelendi."},
+		},
+		{
+			{HeadingBlock, "", "KARAR"},
+			{ListItemBlock, "1.", "1. Davalının temyiz itirazlarının reddine,"},
+			{ListItemBlock, "2.", "2. Hükmün ONANMASINA,"},
+			{ListItemBlock, "a)", "a) Harcın iadesine,"},
+			{ListItemBlock, "(I)", "(I) 17.02.2014 gününde oybirliğiyle karar verildi.\n19. yüzyıldan kalma bir kural da uygulandı."},
+		},
+	}
+
+	if len(doc.Sections) != len(want) {
+		t.Fatalf("%d sections, want %d", len(doc.Sections), len(want))
+	}
+	runes := []rune(legalDocument)
+	for i, section := range doc.Sections {
+		blocks := section.Blocks
+		if section.Heading != nil {
+			blocks = append([]*Block{section.Heading}, blocks...)
+		}
+		if len(blocks) != len(want[i]) {
+			t.Errorf("section %d has %d blocks, want %d", i, len(blocks), len(want[i]))
+			continue
+		}
+		for j, b := range blocks {
+			w := want[i][j]
+			if b.Type != w.typ || b.Marker != w.marker || b.Text != w.text {
+				t.Errorf("section %d block %d = %s %q %q, want %s %q %q", i, j,
+					BlockTypeName(b.Type), b.Marker, b.Text, BlockTypeName(w.typ), w.marker, w.text)
+			}
+			if s := legalDocument[b.StartByte:b.EndByte]; s != b.Text {
+				t.Errorf("bytes of block %q are %q", b.Text, s)
+			}
+			for _, sentence := range b.Sentences {
+				if s := string(runes[sentence.Start:sentence.End]); s != sentence.Text {
+					t.Errorf("runes of sentence %q are %q", sentence.Text, s)
+				}
+			}
+		}
+	}
+
+	sentences := doc.Sentences()
+	wantSentences := []string{
+		"13. Hukuk Dairesi 2014/4087 E., 2014/3970 K.",
+		"İçtihat Metni",
+		"Tüketici Mahkemesi",
+		"Taraflar arasındaki alacak davasının yapılan yargılaması sonunda davanın kabulüne\nyönelik olarak verilen hüküm davalı avukatınca temyiz edildi.",
+		"Dosya incelendi.",
+		"KARAR",
+		"Davalının temyiz itirazlarının reddine,",
+		"Hükmün ONANMASINA,",
+		"Harcın iadesine,",
+		"17.02.2014 gününde oybirliğiyle karar verildi.",
+		"19. yüzyıldan kalma bir kural da uygulandı.",
+	}
+	if len(sentences) != len(wantSentences) {
+		for _, s := range sentences {
+			t.Logf("%q", s.Text)
+		}
+		t.Fatalf("%d sentences, want %d", len(sentences), len(wantSentences))
+	}
+	for i, s := range sentences {
+		if s.Text != wantSentences[i] {
+			t.Errorf("sentence %d = %q, want %q", i, s.Text, wantSentences[i])
+		}
+	}
+}
+
+func TestMarkerValue(t *testing.T) {
+	tests := map[string]int{"1.": 1, "12.": 12, "IV.": 4, "XIX.": 19, "a)": 0, "3-": 0}
+	for marker, want := range tests {
+		if got := markerValue(marker); got != want {
+			t.Errorf("markerValue(%q) = %d, want %d", marker, got, want)
+		}
+	}
+}
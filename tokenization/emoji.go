@@ -0,0 +1,191 @@
+package tokenization
+
+import "unicode"
+
+// Emoji recognition follows Unicode Technical Standard #51. Go's unicode
+// package has no emoji properties, so the Extended_Pictographic and
+// Emoji_Presentation ranges of emoji-data.txt are listed here.
+
+const (
+	zeroWidthJoiner       = '\u200d'
+	textPresentation      = '\ufe0e'
+	emojiPresentation     = '\ufe0f'
+	combiningKeycap       = '\u20e3'
+	regionalIndicatorA    = 0x1f1e6
+	regionalIndicatorZ    = 0x1f1ff
+	emojiModifierFirst    = 0x1f3fb
+	emojiModifierLast     = 0x1f3ff
+	tagFirst, tagLast     = 0xe0020, 0xe007f
+	mahjongFirst          = 0x1f000
+	playingCardsLast      = 0x1f0ff
+	mahjongRedDragon      = 0x1f004
+	playingCardBlackJoker = 0x1f0cf
+)
+
+// extendedPictographic holds the runes with the Extended_Pictographic property
+var extendedPictographic = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x00a9, 0x00a9, 1}, {0x00ae, 0x00ae, 1}, {0x203c, 0x203c, 1},
+		{0x2049, 0x2049, 1}, {0x2122, 0x2122, 1}, {0x2139, 0x2139, 1},
+		{0x2194, 0x2199, 1}, {0x21a9, 0x21aa, 1}, {0x231a, 0x231b, 1},
+		{0x2328, 0x2328, 1}, {0x2388, 0x2388, 1}, {0x23cf, 0x23cf, 1},
+		{0x23e9, 0x23f3, 1}, {0x23f8, 0x23fa, 1}, {0x24c2, 0x24c2, 1},
+		{0x25aa, 0x25ab, 1}, {0x25b6, 0x25b6, 1}, {0x25c0, 0x25c0, 1},
+		{0x25fb, 0x25fe, 1}, {0x2600, 0x2605, 1}, {0x2607, 0x2612, 1},
+		{0x2614, 0x2685, 1}, {0x2690, 0x2705, 1}, {0x2708, 0x2712, 1},
+		{0x2714, 0x2714, 1}, {0x2716, 0x2716, 1}, {0x271d, 0x271d, 1},
+		{0x2721, 0x2721, 1}, {0x2728, 0x2728, 1}, {0x2733, 0x2734, 1},
+		{0x2744, 0x2744, 1}, {0x2747, 0x2747, 1}, {0x274c, 0x274c, 1},
+		{0x274e, 0x274e, 1}, {0x2753, 0x2755, 1}, {0x2757, 0x2757, 1},
+		{0x2763, 0x2767, 1}, {0x2795, 0x2797, 1}, {0x27a1, 0x27a1, 1},
+		{0x27b0, 0x27b0, 1}, {0x27bf, 0x27bf, 1}, {0x2934, 0x2935, 1},
+		{0x2b05, 0x2b07, 1}, {0x2b1b, 0x2b1c, 1}, {0x2b50, 0x2b50, 1},
+		{0x2b55, 0x2b55, 1}, {0x3030, 0x3030, 1}, {0x303d, 0x303d, 1},
+		{0x3297, 0x3297, 1}, {0x3299, 0x3299, 1},
+	},
+	R32: []unicode.Range32{
+		{0x1f000, 0x1f0ff, 1}, {0x1f10d, 0x1f10f, 1}, {0x1f12f, 0x1f12f, 1},
+		{0x1f16c, 0x1f171, 1}, {0x1f17e, 0x1f17f, 1}, {0x1f18e, 0x1f18e, 1},
+		{0x1f191, 0x1f19a, 1}, {0x1f1ad, 0x1f1e5, 1}, {0x1f201, 0x1f20f, 1},
+		{0x1f21a, 0x1f21a, 1}, {0x1f22f, 0x1f22f, 1}, {0x1f232, 0x1f23a, 1},
+		{0x1f23c, 0x1f23f, 1}, {0x1f249, 0x1f3fa, 1}, {0x1f400, 0x1f53d, 1},
+		{0x1f546, 0x1f64f, 1}, {0x1f680, 0x1f6ff, 1}, {0x1f774, 0x1f77f, 1},
+		{0x1f7d5, 0x1f7ff, 1}, {0x1f80c, 0x1f80f, 1}, {0x1f848, 0x1f84f, 1},
+		{0x1f85a, 0x1f85f, 1}, {0x1f888, 0x1f88f, 1}, {0x1f8ae, 0x1f8ff, 1},
+		{0x1f90c, 0x1f93a, 1}, {0x1f93c, 0x1f945, 1}, {0x1f947, 0x1faff, 1},
+		{0x1fc00, 0x1fffd, 1},
+	},
+}
+
+// emojiPresentationBMP holds the runes below U+10000 that are displayed as
+// emoji by default. Other pictographs there are text unless followed by
+// U+FE0F, like "❤️" and "©️".
+var emojiPresentationBMP = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x231a, 0x231b, 1}, {0x23e9, 0x23ec, 1}, {0x23f0, 0x23f0, 1},
+		{0x23f3, 0x23f3, 1}, {0x25fd, 0x25fe, 1}, {0x2614, 0x2615, 1},
+		{0x2648, 0x2653, 1}, {0x267f, 0x267f, 1}, {0x2693, 0x2693, 1},
+		{0x26a1, 0x26a1, 1}, {0x26aa, 0x26ab, 1}, {0x26bd, 0x26be, 1},
+		{0x26c4, 0x26c5, 1}, {0x26ce, 0x26ce, 1}, {0x26d4, 0x26d4, 1},
+		{0x26ea, 0x26ea, 1}, {0x26f2, 0x26f3, 1}, {0x26f5, 0x26f5, 1},
+		{0x26fa, 0x26fa, 1}, {0x26fd, 0x26fd, 1}, {0x2705, 0x2705, 1},
+		{0x270a, 0x270b, 1}, {0x2728, 0x2728, 1}, {0x274c, 0x274c, 1},
+		{0x274e, 0x274e, 1}, {0x2753, 0x2755, 1}, {0x2757, 0x2757, 1},
+		{0x2795, 0x2797, 1}, {0x27b0, 0x27b0, 1}, {0x27bf, 0x27bf, 1},
+		{0x2b1b, 0x2b1c, 1}, {0x2b50, 0x2b50, 1}, {0x2b55, 0x2b55, 1},
+	},
+}
+
+func isRegionalIndicator(r rune) bool {
+	return r >= regionalIndicatorA && r <= regionalIndicatorZ
+}
+
+func isEmojiModifier(r rune) bool {
+	return r >= emojiModifierFirst && r <= emojiModifierLast
+}
+
+func isTag(r rune) bool {
+	return r >= tagFirst && r <= tagLast
+}
+
+func isKeycapBase(r rune) bool {
+	return r >= '0' && r <= '9' || r == '#' || r == '*'
+}
+
+func isPictographic(r rune) bool {
+	return r >= 0xa9 && unicode.Is(extendedPictographic, r)
+}
+
+// isEmojiPresentation reports whether the pictograph r is displayed as
+// emoji without a variation selector. Pictographs above U+FFFF are, except
+// for mahjong tiles and playing cards.
+func isEmojiPresentation(r rune) bool {
+	if r > 0xffff {
+		return r > playingCardsLast || r < mahjongFirst || r == mahjongRedDragon || r == playingCardBlackJoker
+	}
+	return unicode.Is(emojiPresentationBMP, r)
+}
+
+// mayStartEmoji is a quick check for runes an emoji sequence can start with
+func mayStartEmoji(r rune) bool {
+	return isKeycapBase(r) || r >= 0xa9 && (isRegionalIndicator(r) || isEmojiModifier(r) || isPictographic(r))
+}
+
+// emoji matches an emoji sequence: emoji elements joined with zero width
+// joiners, as in "👩‍💻"
+func (l *lexer) emoji(i int) int {
+	end := l.emojiElement(i)
+	if end < 0 {
+		return -1
+	}
+	for l.at(end) == zeroWidthJoiner {
+		next := l.emojiElement(end + 1)
+		if next < 0 {
+			break
+		}
+		end = next
+	}
+	return end
+}
+
+// emojiElement matches a flag of two regional indicators, a keycap like
+// "1️⃣" or a pictograph with an optional presentation selector, skin tone
+// modifier and tags, as in "👍🏽" and "🏴󠁧󠁢󠁳󠁣󠁴󠁿"
+func (l *lexer) emojiElement(i int) int {
+	r := l.at(i)
+	switch {
+	case isRegionalIndicator(r):
+		if isRegionalIndicator(l.at(i + 1)) {
+			return i + 2
+		}
+		return i + 1
+	case isKeycapBase(r):
+		j := i + 1
+		if l.at(j) == emojiPresentation {
+			j++
+		}
+		if l.at(j) == combiningKeycap {
+			return j + 1
+		}
+		return -1
+	case isEmojiModifier(r):
+		return i + 1
+	case !isPictographic(r):
+		return -1
+	}
+
+	j := i + 1
+	switch next := l.at(j); {
+	case next == emojiPresentation:
+		j++
+	case next == textPresentation:
+		return -1
+	case !isEmojiPresentation(r) && !isEmojiModifier(next) &&
+		!(next == zeroWidthJoiner && l.emojiElement(j+1) >= 0):
+		return -1
+	}
+	if isEmojiModifier(l.at(j)) {
+		j++
+	}
+	for isTag(l.at(j)) {
+		j++
+	}
+	return j
+}
+
+// isSymbol reports whether r is a symbol that is not punctuation
+func isSymbol(r rune) bool {
+	return !punctuationSet[r] && unicode.IsSymbol(r)
+}
+
+// symbol matches a run of symbols that are not emoji, like "€" or "→"
+func (l *lexer) symbol(i int) int {
+	end := i
+	for isSymbol(l.at(end)) && !(mayStartEmoji(l.at(end)) && l.emoji(end) >= 0) {
+		end = l.marks(end + 1)
+	}
+	if end == i {
+		return -1
+	}
+	return end
+}
@@ -0,0 +1,98 @@
+package tokenization
+
+import "testing"
+
+func TestEmojiTokens(t *testing.T) {
+	tests := []struct {
+		input string
+		want  []string
+		types []TokenType
+	}{
+		{"güzel😀", []string{"güzel", "😀"}, []TokenType{WordAlphanumerical, Emoji}},
+		{"😀😀", []string{"😀", "😀"}, []TokenType{Emoji, Emoji}},
+		{"👍🏽", []string{"👍🏽"}, []TokenType{Emoji}},
+		{"👩‍💻 kod", []string{"👩‍💻", "kod"}, []TokenType{Emoji, WordAlphanumerical}},
+		{"👨‍👩‍👧", []string{"👨‍👩‍👧"}, []TokenType{Emoji}},
+		{"🇹🇷🇩🇪", []string{"🇹🇷", "🇩🇪"}, []TokenType{Emoji, Emoji}},
+		{"🏴\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f", []string{"🏴\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f"}, []TokenType{Emoji}},
+		{"❤️ seni", []string{"❤️", "seni"}, []TokenType{Emoji, WordAlphanumerical}},
+		{"1️⃣ 2", []string{"1️⃣", "2"}, []TokenType{Emoji, Number}},
+		{"⚽gol", []string{"⚽", "gol"}, []TokenType{Emoji, WordAlphanumerical}},
+		// pictographs that are text by default stay symbols and punctuation
+		{"★ ©", []string{"★", "©"}, []TokenType{Symbol, Punctuation}},
+		{"©️", []string{"©️"}, []TokenType{Emoji}},
+		{"€100 →→", []string{"€", "100", "→→"}, []TokenType{Symbol, Number, Symbol}},
+		{"25°C bugün", []string{"25", "°", "C", "bugün"}, []TokenType{Number, Symbol, WordAlphanumerical, WordAlphanumerical}},
+	}
+	for _, tt := range tests {
+		tokens := DEFAULT.Tokenize(tt.input)
+		if len(tokens) != len(tt.want) {
+			t.Errorf("Tokenize(%q) = %v, want %q", tt.input, tokens, tt.want)
+			continue
+		}
+		for i, token := range tokens {
+			if token.Content != tt.want[i] || token.Type != tt.types[i] {
+				t.Errorf("Tokenize(%q) token %d = %q %s, want %q %s", tt.input, i,
+					token.Content, TokenTypeName(token.Type), tt.want[i], TokenTypeName(tt.types[i]))
+			}
+		}
+	}
+}
+
+func TestMarksInWords(t *testing.T) {
+	tokens := DEFAULT.Tokenize("ISTANBUL'DA i̇stanbul'da çiçek︎")
+	want := []struct {
+		content, normalized string
+	}{
+		{"ISTANBUL'DA", "ISTANBUL'DA"},
+		{"i̇stanbul'da", "istanbul'da"},
+		{"çiçek︎", "çiçek"},
+	}
+	if len(tokens) != len(want) {
+		t.Fatalf("tokens = %v", tokens)
+	}
+	for i, w := range want {
+		if tokens[i].Content != w.content || tokens[i].Normalized != w.normalized || tokens[i].Type != WordAlphanumerical {
+			t.Errorf("token %d = %q %q %s", i, tokens[i].Content, tokens[i].Normalized, TokenTypeName(tokens[i].Type))
+		}
+	}
+}
+
+func TestUnicodeSpaces(t *testing.T) {
+	got := ALL.TokenizeToStrings("bir iki üç")
+	want := []string{"bir", " ", "iki", " ", "üç"}
+	if len(got) != len(want) {
+		t.Fatalf("tokens = %q", got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("token %d = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestDetermineEmojiAndSymbol(t *testing.T) {
+	tests := map[string]TokenType{
+		"😀":         Emoji,
+		"1️⃣":       Emoji,
+		"👍🏽":        Emoji,
+		"€":         Symbol,
+		"→→":        Symbol,
+		"i̇stanbul": Word,
+	}
+	for word, want := range tests {
+		if got := DetermineTokenType(word); got != want {
+			t.Errorf("DetermineTokenType(%q) = %s, want %s", word, TokenTypeName(got), TokenTypeName(want))
+		}
+	}
+}
+
+func TestTokenTypeValues(t *testing.T) {
+	// values of the types that existed before Symbol must not change
+	if UnknownWord != 21 || Unknown != 22 || Symbol != 23 {
+		t.Errorf("UnknownWord = %d, Unknown = %d, Symbol = %d", UnknownWord, Unknown, Symbol)
+	}
+	if got, ok := TokenTypeFromName("Symbol"); !ok || got != Symbol {
+		t.Errorf("TokenTypeFromName(Symbol) = %v, %v", got, ok)
+	}
+}
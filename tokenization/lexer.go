@@ -0,0 +1,572 @@
+package tokenization
+
+import (
+	"unicode"
+
+	"github.com/kalaomer/zemberek-go/core/turkish"
+)
+
+// The lexer recognizes the same tokens as the patterns in patterns.go
+// without regular expressions. Every matcher below mirrors one pattern and
+// returns the rune index where its match ends, or -1. Matches start at the
+// current position of the lexer, and like the patterns the longest match
+// wins, earlier matchers winning ties.
+
+var (
+	letterSet  = runeSet(turkish.Instance.AllLetters)
+	capitalSet = runeSet(turkish.Instance.Uppercase)
+
+	// characters of URL paths after the scheme or "www." and after a domain
+	urlSchemeSet = runeSet("-_/?&+;=[].:")
+	urlPathSet   = runeSet("-_/?&+;=[].")
+
+	punctuationSet = runeSet(".,!?%$&*+@:;®™©℠>…=\\/()[]{}^'\"-")
+	// characters that end an unknown word
+	unknownWordStopSet = runeSet(" \n\r\t.,!?%$&*+@:;…®™©℠=>'\"»«\\-/()[]{}^")
+
+	romanSet = runeSet("IVXLCDM")
+	tldList  = [][]rune{[]rune("com"), []rune("org"), []rune("edu"), []rune("gov"), []rune("net"), []rune("info")}
+
+	emoticons = [][]rune{
+		[]rune(":)"), []rune(":-)"), []rune(":-]"), []rune(":D"), []rune(":-D"), []rune("8-)"),
+		[]rune(";)"), []rune(";‑)"), []rune(":("), []rune(":-("), []rune(":'("), []rune(":')"),
+		[]rune(":P"), []rune(":p"), []rune(":|"), []rune("=|"), []rune("=)"), []rune("=("),
+		[]rune(":‑/"), []rune(":/"), []rune(":^)"), []rune(`¯\_(ツ)_/¯`), []rune("O_o"), []rune("o_O"),
+		[]rune("O_O"), []rune(`\o/`), []rune("<3"),
+	}
+)
+
+func runeSet(s string) map[rune]bool {
+	set := make(map[rune]bool)
+	for _, r := range s {
+		set[r] = true
+	}
+	return set
+}
+
+type lexer struct {
+	runes []rune
+	// abbreviations holds the abbreviations whose dots are kept, nil for
+	// DefaultAbbreviations
+	abbreviations *AbbreviationRegistry
+}
+
+// at returns the rune at i, or 0 past the end of the input
+func (l *lexer) at(i int) rune {
+	if i < len(l.runes) {
+		return l.runes[i]
+	}
+	return 0
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+func isLetter(r rune) bool {
+	if r < 0x80 {
+		return r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z'
+	}
+	return letterSet[r]
+}
+
+func isCapital(r rune) bool {
+	if r < 0x80 {
+		return r >= 'A' && r <= 'Z'
+	}
+	return capitalSet[r]
+}
+
+func isAlphanumeric(r rune) bool {
+	return isDigit(r) || isLetter(r)
+}
+
+func isAlphanumericUnderscore(r rune) bool {
+	return r == '_' || isAlphanumeric(r)
+}
+
+// run returns the end of the runes matching f from i
+func (l *lexer) run(i int, f func(rune) bool) int {
+	for i < len(l.runes) && f(l.runes[i]) {
+		i++
+	}
+	return i
+}
+
+// isMark reports whether r is a combining mark or a variation selector
+func isMark(r rune) bool {
+	return r >= 0x300 && unicode.In(r, unicode.Mn, unicode.Me)
+}
+
+// marks returns the end of the combining marks and variation selectors
+// from i, as in the decomposed "i̇"
+func (l *lexer) marks(i int) int {
+	return l.run(i, isMark)
+}
+
+// runWithMarks is run that also accepts marks following accepted runes
+func (l *lexer) runWithMarks(i int, f func(rune) bool) int {
+	for i < len(l.runes) && f(l.runes[i]) {
+		i = l.marks(i + 1)
+	}
+	return i
+}
+
+func (l *lexer) hasPrefix(i int, prefix []rune) bool {
+	if len(l.runes)-i < len(prefix) {
+		return false
+	}
+	for k, r := range prefix {
+		if l.runes[i+k] != r {
+			return false
+		}
+	}
+	return true
+}
+
+// suffix matches an optional apostrophe and suffix letters: 'e, 'den
+func (l *lexer) suffix(i int) int {
+	if l.at(i) == '\'' && isLetter(l.at(i+1)) {
+		return l.runWithMarks(i+1, isLetter)
+	}
+	return i
+}
+
+// signedDigits matches [+\-]?[0-9]+
+func (l *lexer) signedDigits(i int) int {
+	if r := l.at(i); r == '+' || r == '-' {
+		i++
+	}
+	if end := l.run(i, isDigit); end > i {
+		return end
+	}
+	return -1
+}
+
+// groups matches (f+ sep)+ f+
+func (l *lexer) groups(i int, f func(rune) bool, sep rune) int {
+	prev, last := -1, -1
+	for {
+		end := l.run(i, f)
+		if end == i || l.at(end) != sep {
+			break
+		}
+		i = end + 1
+		prev, last = last, i
+	}
+	if last < 0 {
+		return -1
+	}
+	if end := l.run(last, f); end > last {
+		return end
+	}
+	if prev < 0 {
+		return -1
+	}
+	return l.run(prev, f)
+}
+
+func (l *lexer) whitespace(i int) (int, TokenType) {
+	switch r := l.at(i); {
+	case r == '\n' || r == '\r' || r == '\u0085' || r == '\u2028' || r == '\u2029':
+		return i + 1, NewLine
+	case unicode.IsSpace(r):
+		return i + 1, SpaceTab
+	}
+	return -1, SpaceTab
+}
+
+func (l *lexer) url(i int) int {
+	start := -1
+	switch {
+	case l.hasPrefix(i, []rune("https://")):
+		start = i + 8
+	case l.hasPrefix(i, []rune("http://")):
+		start = i + 7
+	case l.hasPrefix(i, []rune("www.")):
+		start = i + 4
+	}
+	if start >= 0 {
+		end := l.run(start, func(r rune) bool { return isAlphanumeric(r) || urlSchemeSet[r] })
+		if end > start {
+			return l.suffix(end)
+		}
+	}
+
+	end := l.run(i, isAlphanumericUnderscore)
+	if end == i || l.at(end) != '.' {
+		return -1
+	}
+	end++
+	tld := false
+	for _, t := range tldList {
+		if l.hasPrefix(end, t) {
+			end += len(t)
+			tld = true
+			break
+		}
+	}
+	if !tld {
+		return -1
+	}
+	if l.hasPrefix(end, []rune(".tr")) {
+		end += 3
+	}
+	if l.at(end) == '/' {
+		if path := l.run(end+1, func(r rune) bool { return isAlphanumeric(r) || urlPathSet[r] }); path > end+1 {
+			end = path
+		}
+	}
+	return l.suffix(end)
+}
+
+func (l *lexer) email(i int) int {
+	local := l.run(i, isAlphanumericUnderscore)
+	if local == i {
+		return -1
+	}
+	at := -1
+	if l.at(local) == '.' {
+		if end := l.run(local+1, isAlphanumericUnderscore); end > local+1 && l.at(end) == '@' {
+			at = end
+		}
+	}
+	if at < 0 && local-i >= 2 && l.at(local) == '@' {
+		at = local
+	}
+	if at < 0 {
+		return -1
+	}
+	end := l.groups(at+1, isAlphanumericUnderscore, '.')
+	if end < 0 {
+		return -1
+	}
+	return l.suffix(end)
+}
+
+func (l *lexer) date(i int) int {
+	between := func(i int, lo, hi rune) bool {
+		r := l.at(i)
+		return r >= lo && r <= hi
+	}
+	separator := func(i int) bool {
+		r := l.at(i)
+		return r == '.' || r == '/'
+	}
+	year := func(i int) int {
+		switch {
+		case l.at(i) == '1' && between(i+1, '7', '9') && isDigit(l.at(i+2)) && isDigit(l.at(i+3)):
+			return i + 4
+		case l.at(i) == '2' && l.at(i+1) == '0' && isDigit(l.at(i+2)) && isDigit(l.at(i+3)):
+			return i + 4
+		case isDigit(l.at(i)) && isDigit(l.at(i+1)):
+			return i + 2
+		}
+		return -1
+	}
+
+	// optional first digits of day and month, tried with the digit first
+	for _, dayDigit := range []bool{true, false} {
+		j := i
+		if dayDigit {
+			if !between(j, '0', '3') {
+				continue
+			}
+			j++
+		}
+		if !isDigit(l.at(j)) || !separator(j+1) {
+			continue
+		}
+		j += 2
+		for _, monthDigit := range []bool{true, false} {
+			k := j
+			if monthDigit {
+				if !between(k, '0', '1') {
+					continue
+				}
+				k++
+			}
+			if !isDigit(l.at(k)) || !separator(k+1) {
+				continue
+			}
+			if end := year(k + 2); end >= 0 {
+				return l.suffix(end)
+			}
+		}
+	}
+	return -1
+}
+
+func (l *lexer) time(i int) int {
+	between := func(i int, lo, hi rune) bool {
+		r := l.at(i)
+		return r >= lo && r <= hi
+	}
+	separator := func(i int) bool {
+		r := l.at(i)
+		return r == ':' || r == '.'
+	}
+	if !between(i, '0', '2') || !isDigit(l.at(i+1)) || !separator(i+2) || !between(i+3, '0', '5') || !isDigit(l.at(i+4)) {
+		return -1
+	}
+	end := i + 5
+	if separator(end) && between(end+1, '0', '5') && isDigit(l.at(end+2)) {
+		end += 3
+	}
+	return l.suffix(end)
+}
+
+// prefixed matches a prefix rune followed by alphanumerics and underscores,
+// as in mentions and hashtags
+func (l *lexer) prefixed(i int, prefix rune) int {
+	if l.at(i) != prefix {
+		return -1
+	}
+	end := l.runWithMarks(i+1, isAlphanumericUnderscore)
+	if end == i+1 {
+		return -1
+	}
+	return l.suffix(end)
+}
+
+func (l *lexer) metaTag(i int) int {
+	if l.at(i) != '<' {
+		return -1
+	}
+	end := l.run(i+1, isAlphanumericUnderscore)
+	if end == i+1 || l.at(end) != '>' {
+		return -1
+	}
+	return end + 1
+}
+
+func (l *lexer) emoticon(i int) int {
+	for _, e := range emoticons {
+		if l.hasPrefix(i, e) {
+			return i + len(e)
+		}
+	}
+	return -1
+}
+
+func (l *lexer) percent(i int) int {
+	if l.at(i) != '%' {
+		return -1
+	}
+	end := l.signedDigits(i + 1)
+	if end < 0 {
+		return -1
+	}
+	if r := l.at(end); r == '.' || r == ',' {
+		if fraction := l.run(end+1, isDigit); fraction > end+1 {
+			end = fraction
+		}
+	}
+	return l.suffix(end)
+}
+
+func (l *lexer) numberExp(i int) int {
+	exponent := func(i int) int {
+		if r := l.at(i); r != 'E' && r != 'e' {
+			return -1
+		}
+		return l.signedDigits(i + 1)
+	}
+	mantissa := l.signedDigits(i)
+	if mantissa < 0 {
+		return -1
+	}
+	if r := l.at(mantissa); r == '.' || r == ',' {
+		if fraction := l.run(mantissa+1, isDigit); fraction > mantissa+1 {
+			if end := exponent(fraction); end >= 0 {
+				return l.suffix(end)
+			}
+		}
+	}
+	if end := exponent(mantissa); end >= 0 {
+		return l.suffix(end)
+	}
+	return -1
+}
+
+func (l *lexer) numberFraction(i int) int {
+	end := l.signedDigits(i)
+	if end < 0 || l.at(end) != '/' {
+		return -1
+	}
+	denominator := l.run(end+1, isDigit)
+	if denominator == end+1 {
+		return -1
+	}
+	return l.suffix(denominator)
+}
+
+func (l *lexer) numberThousands(i int, sep rune) int {
+	end := l.groups(i, isDigit, sep)
+	if end < 0 {
+		return -1
+	}
+	return l.suffix(end)
+}
+
+func (l *lexer) numberDecimal(i int) int {
+	end := l.signedDigits(i)
+	if end < 0 || l.at(end) != '.' && l.at(end) != ',' {
+		return -1
+	}
+	fraction := l.run(end+1, isDigit)
+	if fraction == end+1 {
+		return -1
+	}
+	return l.suffix(fraction)
+}
+
+func (l *lexer) numberOrdinal(i int) int {
+	end := l.run(i, isDigit)
+	if end == i || l.at(end) != '.' {
+		return -1
+	}
+	return l.suffix(end + 1)
+}
+
+func (l *lexer) numberInteger(i int) int {
+	end := l.signedDigits(i)
+	if end < 0 {
+		return -1
+	}
+	return l.suffix(end)
+}
+
+func (l *lexer) abbreviationWithDots(i int) int {
+	end, pairs := i, 0
+	for isCapital(l.at(end)) && l.at(end+1) == '.' {
+		end += 2
+		pairs++
+	}
+	if pairs >= 2 {
+		if isCapital(l.at(end)) {
+			end++
+		}
+		return l.suffix(end)
+	}
+	if pairs == 1 && isCapital(l.at(i+2)) {
+		return l.suffix(i + 3)
+	}
+	return -1
+}
+
+// romanNumeral only matches up to the end of the input, like its pattern
+func (l *lexer) romanNumeral(i int) int {
+	end := l.run(i, func(r rune) bool { return romanSet[r] })
+	if end == i {
+		return -1
+	}
+	if l.at(end) == '.' {
+		end++
+	}
+	if end = l.suffix(end); end != len(l.runes) {
+		return -1
+	}
+	return end
+}
+
+func (l *lexer) wordWithSymbol(i int) int {
+	end := l.runWithMarks(i, isAlphanumeric)
+	if end == i || l.at(end) != '-' {
+		return -1
+	}
+	second := l.runWithMarks(end+1, isAlphanumeric)
+	if second == end+1 {
+		return -1
+	}
+	return l.suffix(second)
+}
+
+// word matches runes accepted by f with their marks and a suffix
+func (l *lexer) word(i int, f func(rune) bool) int {
+	end := l.runWithMarks(i, f)
+	if end == i {
+		return -1
+	}
+	return l.suffix(end)
+}
+
+func (l *lexer) punctuation(i int) int {
+	switch {
+	case l.hasPrefix(i, []rune("...")), l.hasPrefix(i, []rune("(!)")), l.hasPrefix(i, []rune("(?)")):
+		return i + 3
+	case punctuationSet[l.at(i)]:
+		return i + 1
+	}
+	return -1
+}
+
+// unknownWord matches runes up to a space, punctuation, a symbol or an emoji
+func (l *lexer) unknownWord(i int) int {
+	end := i
+	for ; end < len(l.runes); end++ {
+		r := l.runes[end]
+		if unknownWordStopSet[r] || unicode.IsSpace(r) || isSymbol(r) || mayStartEmoji(r) && l.emoji(end) >= 0 {
+			break
+		}
+	}
+	if end == i {
+		return -1
+	}
+	return end
+}
+
+// next returns the end and type of the token at i. The end is i if no token
+// matches.
+func (l *lexer) next(i int) (int, TokenType) {
+	bestEnd, bestType := i, Unknown
+	consider := func(end int, tokenType TokenType) {
+		if end > bestEnd {
+			bestEnd, bestType = end, tokenType
+		}
+	}
+
+	consider(l.whitespace(i))
+	consider(l.url(i), URL)
+	consider(l.email(i), Email)
+	consider(l.date(i), Date)
+	consider(l.time(i), Time)
+	consider(l.prefixed(i, '@'), Mention)
+	consider(l.prefixed(i, '#'), HashTag)
+	consider(l.metaTag(i), MetaTag)
+	consider(l.emoticon(i), Emoticon)
+	consider(l.emoji(i), Emoji)
+	consider(l.percent(i), PercentNumeral)
+	consider(l.numberExp(i), Number)
+	consider(l.numberFraction(i), Number)
+	consider(l.numberThousands(i, '.'), Number)
+	consider(l.numberThousands(i, ','), Number)
+	consider(l.numberDecimal(i), Number)
+	consider(l.numberOrdinal(i), Number)
+	consider(l.numberInteger(i), Number)
+	consider(l.abbreviationWithDots(i), AbbreviationWithDots)
+	consider(l.romanNumeral(i), RomanNumeral)
+	consider(l.wordWithSymbol(i), WordWithSymbol)
+	consider(l.abbreviation(i, l.word(i, isAlphanumeric), WordAlphanumerical))
+	consider(l.abbreviation(i, l.word(i, isLetter), Word))
+	consider(l.punctuation(i), Punctuation)
+	consider(l.symbol(i), Symbol)
+	consider(l.unknownWord(i), UnknownWord)
+	return bestEnd, bestType
+}
+
+// abbreviation extends a word from start to end with the following dot if
+// they form a known abbreviation like "Prof."
+func (l *lexer) abbreviation(start, end int, tokenType TokenType) (int, TokenType) {
+	if end < 0 || l.at(end) != '.' {
+		return end, tokenType
+	}
+	registry := l.abbreviations
+	if registry == nil {
+		registry = DefaultAbbreviations
+	}
+	if registry.IsAbbreviation(string(l.runes[start:end]) + ".") {
+		return end + 1, Abbreviation
+	}
+	return end, tokenType
+}
@@ -0,0 +1,270 @@
+package tokenization
+
+import (
+	"math/rand"
+	"regexp"
+	"strings"
+	"testing"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Pattern matchers with priority order
+var tokenPatterns = []struct {
+	pattern   *regexp.Regexp
+	tokenType TokenType
+}{
+	// Whitespace (highest priority - single char)
+	{regexp.MustCompile(`^\s`), SpaceTab},
+
+	// URL (before email - can contain @)
+	{urlPattern, URL},
+
+	// Email
+	{emailPattern, Email},
+
+	// Date (before Time - more specific)
+	{datePattern, Date},
+
+	// Time
+	{timePattern, Time},
+
+	// Mention
+	{mentionPattern, Mention},
+
+	// HashTag
+	{hashTagPattern, HashTag},
+
+	// MetaTag
+	{metaTagPattern, MetaTag},
+
+	// Emoticon (before Number - catches "8-)")
+	{emoticonPattern, Emoticon},
+
+	// Percent
+	{percentPattern, PercentNumeral},
+
+	// Number (all formats)
+	{numberExpPattern, Number},
+	{numberFractionPattern, Number},
+	{numberThousandDotPattern, Number},
+	{numberThousandCommaPattern, Number},
+	{numberDecimalPattern, Number},
+	{numberOrdinalPattern, Number},
+	{numberIntegerPattern, Number},
+
+	// Abbreviation with dots
+	{abbreviationWithDotsPattern, AbbreviationWithDots},
+
+	// Roman numeral
+	{romanNumeralPattern, RomanNumeral},
+
+	// Word with symbol
+	{wordWithSymbolPattern, WordWithSymbol},
+
+	// Word alphanumerical
+	{wordAlphanumericalPattern, WordAlphanumerical},
+
+	// Pure word
+	{wordPattern, Word},
+
+	// Punctuation
+	{punctuationPattern, Punctuation},
+
+	// Unknown word
+	{unknownWordPattern, UnknownWord},
+}
+
+// regexTokenize is the regular expression tokenizer the lexer replaced. It
+// tries every pattern at each position and keeps the longest match.
+func regexTokenize(t *TurkishTokenizer, text string) []*Token {
+	tokens := make([]*Token, 0)
+	runes := []rune(text)
+	offsets := NewOffsetMap(text)
+	pos := 0
+
+	for pos < len(runes) {
+		remaining := string(runes[pos:])
+		matched := false
+
+		// Try all patterns and find the longest match
+		var longestMatch struct {
+			text      string
+			tokenType TokenType
+			length    int
+		}
+
+		for _, pt := range tokenPatterns {
+			if loc := pt.pattern.FindStringIndex(remaining); loc != nil && loc[0] == 0 {
+				// Pattern matched at start of remaining text
+				matchedText := remaining[:loc[1]]
+				tokenType := pt.tokenType
+				matchLen := loc[1]
+
+				// Special handling for whitespace types
+				if tokenType == SpaceTab {
+					if matchedText == "\n" || matchedText == "\r" {
+						tokenType = NewLine
+					}
+				}
+
+				// Special case: Word + "." might be abbreviation
+				if (tokenType == Word || tokenType == WordAlphanumerical) && pos+utf8.RuneCountInString(matchedText) < len(runes) && runes[pos+utf8.RuneCountInString(matchedText)] == '.' {
+					withDot := matchedText + "."
+					if IsAbbreviation(withDot) {
+						matchedText = withDot
+						tokenType = Abbreviation
+						matchLen++ // Include the dot in length
+					}
+				}
+
+				// Keep track of longest match
+				if matchLen > longestMatch.length {
+					longestMatch.text = matchedText
+					longestMatch.tokenType = tokenType
+					longestMatch.length = matchLen
+				}
+			}
+		}
+
+		// Use the longest match
+		if longestMatch.length > 0 {
+			matched = true
+			if t.acceptedTypes[longestMatch.tokenType] {
+				tokens = append(tokens, &Token{
+					Content:    longestMatch.text,
+					Type:       longestMatch.tokenType,
+					Span:       *offsets.Span(pos, pos+utf8.RuneCountInString(longestMatch.text)),
+					Normalized: NormalizeApostrophe(longestMatch.text),
+				})
+			}
+			pos += len([]rune(longestMatch.text))
+		}
+
+		if !matched {
+			// No pattern matched - treat as unknown single character
+			if t.acceptedTypes[Unknown] {
+				tokens = append(tokens, &Token{
+					Content:    string(runes[pos]),
+					Type:       Unknown,
+					Span:       *offsets.Span(pos, pos+1),
+					Normalized: string(runes[pos]),
+				})
+			}
+			pos++
+		}
+	}
+
+	return tokens
+}
+
+var lexerSamples = []string{
+	"Merhaba dünya! Bugün hava çok güzel.",
+	"Prof. Dr. Ahmet Yılmaz ve Doç. Ayşe Kaya T.C.K.'yı okudu.",
+	"İ.Ö 500 yılında, I.B.M. ve ABD'de neler oldu?",
+	"http://www.fo.bar https://www.fo.bar/baz?q=1 www.kalaomer.com.tr'ye foo.com.tr/path'te",
+	"ali@gmail.com ve foo.bar@domain.com.tr'ye yazdı; a@b.c",
+	"02.12.1998'de 1/1/2011 1.1.11 12/31/1799 10:20 10.20.00'da 29:61",
+	"@kemal_01'in #türkçe_etiket'e <meta> <tag şöyle",
+	":) :-) :D 8-) ;) :( :'( :P =| :/ :^) ¯\\_(ツ)_/¯ O_o \\o/ <3",
+	"%2.5 %100'e %-3 % 1.35E-9 1e10'dur 1/2 -3/4 1.000.000 2,345,531 -1.35 3,1'e 2. 34.'ncü 45 +3 100'e",
+	"F-16'yı H1N1-A covid-19 F16 covid19 Ahmet'e kitap'",
+	"IX. bölüm ve XII",
+	"IV'ü",
+	"... (!) (?) «alıntı» \"çift\" 'tek' [köşeli] {süslü} ^ \\ / - ®™©℠ … > =",
+	"satır\nsonu\r\nve\tsekme\fform",
+	"yy. vb. vs. bkz. Tel. Mah. Cad. No. St. örn.",
+	"ÇĞİÖŞÜ çğıöşü âîû QWX",
+	"a.b.c A.B a. 1.a 1,a 1.2.3.4,5",
+	"日本語 テキスト emoji😀 ok",
+}
+
+// fuzzRunes are the runes random inputs are built from
+var fuzzRunes = []rune("aAbçÇğİıIVXCM019.,:;/-+%@#<>'\"()!?_ \n\tE²😀")
+
+// unicodeAware reports whether text has runes the lexer classifies by their
+// Unicode properties while the regex tokenizer did not: emoji, symbols,
+// combining marks and spaces other than space, tab and line breaks
+func unicodeAware(text string) bool {
+	for _, r := range text {
+		if isPictographic(r) || isEmojiModifier(r) || isSymbol(r) || isMark(r) ||
+			unicode.IsSpace(r) && !strings.ContainsRune(" \t\n\r", r) {
+			return true
+		}
+	}
+	return false
+}
+
+func compareTokenizers(t *testing.T, text string) {
+	t.Helper()
+	if unicodeAware(text) {
+		return
+	}
+	got := ALL.Tokenize(text)
+	want := regexTokenize(ALL, text)
+	if len(got) != len(want) {
+		t.Errorf("Tokenize(%q) returned %d tokens, regex tokenizer %d\n  got:  %v\n  want: %v", text, len(got), len(want), got, want)
+		return
+	}
+	for i := range got {
+		if got[i].Content != want[i].Content || got[i].Type != want[i].Type || got[i].Span != want[i].Span {
+			t.Errorf("Tokenize(%q) token %d = %v %+v, regex tokenizer %v %+v",
+				text, i, got[i], got[i].Span, want[i], want[i].Span)
+			return
+		}
+	}
+}
+
+func TestLexerMatchesRegexTokenizer(t *testing.T) {
+	for _, s := range lexerSamples {
+		compareTokenizers(t, s)
+	}
+	// every suffix of the samples, so patterns are also tried at the end of input
+	for _, s := range lexerSamples {
+		runes := []rune(s)
+		for i := range runes {
+			compareTokenizers(t, string(runes[i:]))
+		}
+	}
+}
+
+func TestLexerMatchesRegexTokenizerRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	var sb strings.Builder
+	for n := 0; n < 20000; n++ {
+		sb.Reset()
+		for i := r.Intn(12) + 1; i > 0; i-- {
+			sb.WriteRune(fuzzRunes[r.Intn(len(fuzzRunes))])
+		}
+		compareTokenizers(t, sb.String())
+		if t.Failed() {
+			return
+		}
+	}
+}
+
+func FuzzLexer(f *testing.F) {
+	for _, s := range lexerSamples {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, text string) {
+		if !utf8.ValidString(text) {
+			return
+		}
+		compareTokenizers(t, text)
+	})
+}
+
+func BenchmarkTokenize(b *testing.B) {
+	text := strings.Repeat(strings.Join(lexerSamples, " "), 10)
+	b.Run("lexer", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			ALL.Tokenize(text)
+		}
+	})
+	b.Run("regex", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			regexTokenize(ALL, text)
+		}
+	})
+}
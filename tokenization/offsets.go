@@ -0,0 +1,104 @@
+package tokenization
+
+import (
+	"sort"
+	"unicode/utf8"
+)
+
+// OffsetMap converts offsets in a text between runes, bytes and UTF-16 code
+// units, as used by JavaScript and LSP clients. Offsets inside a multi-byte
+// rune or a surrogate pair convert to the rune.
+type OffsetMap struct {
+	// bytes and utf16 hold the offsets of every rune and of the text end
+	bytes []int
+	utf16 []int
+}
+
+// NewOffsetMap creates an offset map of text. Invalid bytes count as one
+// rune each, like utf8.DecodeRuneInString decodes them.
+func NewOffsetMap(text string) *OffsetMap {
+	m := &OffsetMap{
+		bytes: make([]int, 0, len(text)+1),
+		utf16: make([]int, 0, len(text)+1),
+	}
+	u := 0
+	for i := 0; i < len(text); {
+		r, size := utf8.DecodeRuneInString(text[i:])
+		m.bytes = append(m.bytes, i)
+		m.utf16 = append(m.utf16, u)
+		i += size
+		u += utf16Len(r)
+	}
+	m.bytes = append(m.bytes, len(text))
+	m.utf16 = append(m.utf16, u)
+	return m
+}
+
+// utf16Len returns the number of UTF-16 code units encoding r
+func utf16Len(r rune) int {
+	if r >= 0x10000 {
+		return 2
+	}
+	return 1
+}
+
+// UTF16Len returns the length of text in UTF-16 code units
+func UTF16Len(text string) int {
+	n := 0
+	for _, r := range text {
+		n += utf16Len(r)
+	}
+	return n
+}
+
+// Len returns the length of the text in runes
+func (m *OffsetMap) Len() int {
+	return len(m.bytes) - 1
+}
+
+// RuneToByte returns the byte offset of the rune offset i
+func (m *OffsetMap) RuneToByte(i int) int {
+	return m.bytes[i]
+}
+
+// RuneToUTF16 returns the UTF-16 offset of the rune offset i
+func (m *OffsetMap) RuneToUTF16(i int) int {
+	return m.utf16[i]
+}
+
+// ByteToRune returns the rune offset of the byte offset i
+func (m *OffsetMap) ByteToRune(i int) int {
+	return search(m.bytes, i)
+}
+
+// UTF16ToRune returns the rune offset of the UTF-16 offset i
+func (m *OffsetMap) UTF16ToRune(i int) int {
+	return search(m.utf16, i)
+}
+
+// ByteToUTF16 returns the UTF-16 offset of the byte offset i
+func (m *OffsetMap) ByteToUTF16(i int) int {
+	return m.utf16[m.ByteToRune(i)]
+}
+
+// UTF16ToByte returns the byte offset of the UTF-16 offset i
+func (m *OffsetMap) UTF16ToByte(i int) int {
+	return m.bytes[m.UTF16ToRune(i)]
+}
+
+// search returns the index of the last offset not after i
+func search(offsets []int, i int) int {
+	return sort.Search(len(offsets), func(k int) bool { return offsets[k] > i }) - 1
+}
+
+// Span returns the span of the runes from start to end with all offsets set
+func (m *OffsetMap) Span(start, end int) *Span {
+	return &Span{
+		Start:      start,
+		End:        end,
+		StartByte:  m.bytes[start],
+		EndByte:    m.bytes[end],
+		StartUTF16: m.utf16[start],
+		EndUTF16:   m.utf16[end],
+	}
+}
@@ -0,0 +1,78 @@
+package tokenization
+
+import (
+	"testing"
+	"unicode/utf16"
+)
+
+func TestOffsetMap(t *testing.T) {
+	m := NewOffsetMap("aç😀b")
+	tests := []struct {
+		rune, byte, utf16 int
+	}{
+		{0, 0, 0},
+		{1, 1, 1},
+		{2, 3, 2},
+		{3, 7, 4},
+		{4, 8, 5},
+	}
+	if m.Len() != 4 {
+		t.Errorf("Len() = %d", m.Len())
+	}
+	for _, tt := range tests {
+		if b := m.RuneToByte(tt.rune); b != tt.byte {
+			t.Errorf("RuneToByte(%d) = %d, want %d", tt.rune, b, tt.byte)
+		}
+		if u := m.RuneToUTF16(tt.rune); u != tt.utf16 {
+			t.Errorf("RuneToUTF16(%d) = %d, want %d", tt.rune, u, tt.utf16)
+		}
+		if r := m.ByteToRune(tt.byte); r != tt.rune {
+			t.Errorf("ByteToRune(%d) = %d, want %d", tt.byte, r, tt.rune)
+		}
+		if r := m.UTF16ToRune(tt.utf16); r != tt.rune {
+			t.Errorf("UTF16ToRune(%d) = %d, want %d", tt.utf16, r, tt.rune)
+		}
+		if u := m.ByteToUTF16(tt.byte); u != tt.utf16 {
+			t.Errorf("ByteToUTF16(%d) = %d, want %d", tt.byte, u, tt.utf16)
+		}
+		if b := m.UTF16ToByte(tt.utf16); b != tt.byte {
+			t.Errorf("UTF16ToByte(%d) = %d, want %d", tt.utf16, b, tt.byte)
+		}
+	}
+	// offsets inside a rune belong to the rune
+	if r := m.ByteToRune(5); r != 2 {
+		t.Errorf("ByteToRune(5) = %d", r)
+	}
+	if r := m.UTF16ToRune(3); r != 2 {
+		t.Errorf("UTF16ToRune(3) = %d", r)
+	}
+	if n := UTF16Len("aç😀b"); n != 5 {
+		t.Errorf("UTF16Len = %d", n)
+	}
+}
+
+func TestTokenOffsets(t *testing.T) {
+	text := "İstanbul'a 😀 gittik, ğüşö 3. kez!"
+	units := utf16.Encode([]rune(text))
+	runes := []rune(text)
+	for _, token := range ALL.Tokenize(text) {
+		if s := text[token.StartByte:token.EndByte]; s != token.Content {
+			t.Errorf("bytes of %v are %q", token, s)
+		}
+		if s := string(runes[token.Start:token.End]); s != token.Content {
+			t.Errorf("runes of %v are %q", token, s)
+		}
+		if s := string(utf16.Decode(units[token.StartUTF16:token.EndUTF16])); s != token.Content {
+			t.Errorf("UTF-16 code units of %v are %q", token, s)
+		}
+	}
+
+	base := NewOffsetMap(text).Span(11, 13)
+	span := NewOffsetMap("😀 gittik").Span(2, 8)
+	if got, want := *span.CopyWithin(base), *NewOffsetMap(text).Span(13, 19); got != want {
+		t.Errorf("CopyWithin() = %+v, want %+v", got, want)
+	}
+	if got := *span.Copy(11); got != (Span{Start: 13, End: 19}) {
+		t.Errorf("Copy(11) = %+v", got)
+	}
+}
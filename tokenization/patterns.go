@@ -23,7 +23,7 @@ var (
 )
 
 // Compiled regex patterns for token detection
-// Pattern priority order matters: URL before Email, Time before Number, etc.
+// The lexer in lexer.go recognizes the same tokens without them
 var (
 	// Time: 10:20, 10:20:53, 10.20.00'da
 	timePattern = regexp.MustCompile(`^[0-2][0-9][:\.][0-5][0-9]([:\.][0-5][0-9])?(` + aposAndSuffix + `)?`)
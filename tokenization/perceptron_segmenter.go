@@ -1,36 +1,21 @@
 package tokenization
 
 import (
-	"bufio"
 	"encoding/csv"
 	"fmt"
 	"io"
+	"io/fs"
 	"os"
-	"regexp"
 	"strings"
 	"unicode"
 )
 
 var (
-	webWords         = []string{"http:", ".html", "www", ".tr", ".edu", ".com", ".net", ".gov", ".org", "@"}
-	lowercaseVowels  = map[rune]bool{'a': true, 'e': true, 'ı': true, 'i': true, 'o': true, 'ö': true, 'u': true, 'ü': true, 'â': true, 'î': true, 'û': true}
-	uppercaseVowels  = map[rune]bool{'A': true, 'E': true, 'I': true, 'İ': true, 'O': true, 'Ö': true, 'U': true, 'Ü': true, 'Â': true, 'Î': true, 'Û': true}
-	whitespaceRegex  = regexp.MustCompile(`\s+`)
-	trailingDotRegex = regexp.MustCompile(`\.$`)
+	webWords        = []string{"http:", ".html", "www", ".tr", ".edu", ".com", ".net", ".gov", ".org", "@"}
+	lowercaseVowels = map[rune]bool{'a': true, 'e': true, 'ı': true, 'i': true, 'o': true, 'ö': true, 'u': true, 'ü': true, 'â': true, 'î': true, 'û': true}
+	uppercaseVowels = map[rune]bool{'A': true, 'E': true, 'I': true, 'İ': true, 'O': true, 'Ö': true, 'U': true, 'Ü': true, 'Â': true, 'Î': true, 'Û': true}
 )
 
-// PerceptronSegmenter loads Binary Averaged Perceptron model for sentence boundary detection
-type PerceptronSegmenter struct {
-	TurkishAbbreviationSet map[string]bool
-}
-
-// NewPerceptronSegmenter creates a new PerceptronSegmenter
-func NewPerceptronSegmenter() *PerceptronSegmenter {
-	return &PerceptronSegmenter{
-		TurkishAbbreviationSet: LoadAbbreviations(""),
-	}
-}
-
 // LoadWeightsFromCSV loads model weights from CSV file
 func LoadWeightsFromCSV(path string) (map[string]float64, error) {
 	if path == "" {
@@ -38,15 +23,33 @@ func LoadWeightsFromCSV(path string) (map[string]float64, error) {
 		path = "resources/sentence_boundary_model_weights.csv"
 	}
 
-	weights := make(map[string]float64)
 	file, err := os.Open(path)
 	if err != nil {
 		return nil, err
 	}
 	defer file.Close()
 
-	reader := csv.NewReader(file)
+	return ReadWeights(file)
+}
+
+// LoadWeightsFromFS loads model weights stored under name in fsys
+func LoadWeightsFromFS(fsys fs.FS, name string) (map[string]float64, error) {
+	file, err := fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return ReadWeights(file)
+}
+
+// ReadWeights reads tab separated feature/weight pairs from r
+func ReadWeights(r io.Reader) (map[string]float64, error) {
+	weights := make(map[string]float64)
+	reader := csv.NewReader(r)
 	reader.Comma = '\t'
+	// features may contain quotes, like the letter after a boundary
+	reader.LazyQuotes = true
 
 	for {
 		record, err := reader.Read()
@@ -69,31 +72,20 @@ func LoadWeightsFromCSV(path string) (map[string]float64, error) {
 	return weights, nil
 }
 
-// LoadAbbreviations loads Turkish abbreviations from a text file
-func LoadAbbreviations(path string) map[string]bool {
-	if path == "" {
-		path = "resources/abbreviations.txt"
-	}
-
-	abbrSet := make(map[string]bool)
-	file, err := os.Open(path)
+// LoadAbbreviationsFS loads a registry of the abbreviations stored under
+// name in fsys, in the format of AbbreviationRegistry.Read
+func LoadAbbreviationsFS(fsys fs.FS, name string) (*AbbreviationRegistry, error) {
+	file, err := fsys.Open(name)
 	if err != nil {
-		return abbrSet
+		return nil, err
 	}
 	defer file.Close()
 
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		line := strings.TrimSpace(scanner.Text())
-		if len(line) > 0 {
-			abbr := whitespaceRegex.ReplaceAllString(line, "")
-			abbr = trailingDotRegex.ReplaceAllString(abbr, "")
-			abbrSet[abbr] = true
-			abbrSet[turkishLower(abbr)] = true
-		}
+	registry := NewAbbreviationRegistry()
+	if err := registry.Read(file, ""); err != nil {
+		return nil, err
 	}
-
-	return abbrSet
+	return registry, nil
 }
 
 func turkishLower(s string) string {
@@ -0,0 +1,163 @@
+package tokenization
+
+import (
+	"sort"
+	"unicode"
+)
+
+// QuoteMode tells how sentence extraction treats quotes and brackets
+type QuoteMode int
+
+const (
+	// QuotesIgnored splits sentences inside quotes and brackets like
+	// anywhere else
+	QuotesIgnored QuoteMode = iota
+	// QuotesInSentence keeps quoted speech and bracketed text inside the
+	// enclosing sentence. A sentence can still end right after a closing
+	// mark, as in `“Geldim.” Sonra oturdu.`
+	QuotesInSentence
+	// QuotesNested is like QuotesInSentence, and Extract also returns the
+	// sentences inside quotes and brackets, linked to the enclosing sentence
+	QuotesNested
+)
+
+// quotePairs maps opening quotes and brackets to their closing marks.
+// A straight double quote closes itself.
+var quotePairs = map[rune]rune{
+	'«': '»',
+	'“': '”',
+	'‘': '’',
+	'(': ')',
+	'[': ']',
+	'"': '"',
+}
+
+// closingQuotes holds the closing marks of quotePairs
+var closingQuotes = func() map[rune]bool {
+	closing := make(map[rune]bool, len(quotePairs))
+	for _, c := range quotePairs {
+		closing[c] = true
+	}
+	return closing
+}()
+
+// quotedSpans returns the spans of matched quotes and brackets, marks
+// included, ordered by start with enclosing spans first. A closing mark
+// closes the innermost open mark it matches and the ones opened after it.
+// A ’ followed by a letter is an apostrophe, as in "Ankara’da", and closes
+// nothing. Closing marks that match no open one and marks left open are
+// ignored.
+func quotedSpans(runes []rune) []*Span {
+	spans := make([]*Span, 0)
+	open := make([]int, 0)
+	for i, r := range runes {
+		if r == '’' && i+1 < len(runes) && unicode.IsLetter(runes[i+1]) {
+			continue
+		}
+		if closingQuotes[r] {
+			k := len(open) - 1
+			for k >= 0 && quotePairs[runes[open[k]]] != r {
+				k--
+			}
+			if k >= 0 {
+				spans = append(spans, &Span{Start: open[k], End: i + 1})
+				open = open[:k]
+				continue
+			}
+		}
+		if _, ok := quotePairs[r]; ok {
+			open = append(open, i)
+		}
+	}
+	sort.Slice(spans, func(a, b int) bool {
+		if spans[a].Start != spans[b].Start {
+			return spans[a].Start < spans[b].Start
+		}
+		return spans[a].End > spans[b].End
+	})
+	return spans
+}
+
+// insideQuotes reports whether a sentence ending at the boundary character
+// at pos would end inside one of spans. A boundary right before closing
+// marks ends the sentence after them, so it is not inside.
+func insideQuotes(runes []rune, spans []*Span, pos int) bool {
+	end := sentenceEnd(runes, pos)
+	for _, span := range spans {
+		if span.Start < pos && end < span.End {
+			return true
+		}
+	}
+	return false
+}
+
+// continuesAfterQuote reports whether the sentence starting at begin goes
+// on after the boundary character at pos: the closing marks after it close
+// a quote or bracket opened in the sentence and the next word starts with a
+// lowercase letter, as in `Kanun (madde 3: "Hüküm. Uygulanır.") açıktır.`
+func continuesAfterQuote(runes []rune, spans []*Span, begin, pos int) bool {
+	end := sentenceEnd(runes, pos)
+	next := end
+	for next < len(runes) && unicode.IsSpace(runes[next]) {
+		next++
+	}
+	if next == len(runes) || !unicode.IsLower(runes[next]) {
+		return false
+	}
+	for _, span := range spans {
+		if span.Start >= begin && span.Start < pos && span.End > pos && span.End <= end {
+			return true
+		}
+	}
+	return false
+}
+
+// Extract returns the sentences of paragraph with their offsets. With
+// QuotesNested, every sentence is followed by the sentences inside its
+// quotes and brackets, which link to it with Parent.
+func (t *TurkishSentenceExtractor) Extract(paragraph string) []*Sentence {
+	runes := []rune(paragraph)
+	offsets := NewOffsetMap(paragraph)
+	var spans []*Span
+	if t.QuoteMode == QuotesNested {
+		spans = quotedSpans(runes)
+	}
+	return t.extractNested(runes, offsets, spans, 0, len(runes), nil, make([]*Sentence, 0))
+}
+
+// extractNested appends the sentences of runes from start to end to
+// sentences, each followed by its nested sentences
+func (t *TurkishSentenceExtractor) extractNested(runes []rune, offsets *OffsetMap, spans []*Span, start, end int, parent *Sentence, sentences []*Sentence) []*Sentence {
+	for _, bounds := range t.sentenceBounds(runes[start:end]) {
+		s, e := start+bounds[0], start+bounds[1]
+		for s < e && unicode.IsSpace(runes[s]) {
+			s++
+		}
+		for e > s && unicode.IsSpace(runes[e-1]) {
+			e--
+		}
+		if s == e {
+			continue
+		}
+		sentence := &Sentence{
+			Text:   string(runes[s:e]),
+			Span:   *offsets.Span(s, e),
+			Parent: parent,
+		}
+		sentences = append(sentences, sentence)
+
+		// quotes directly in the sentence, the ones they enclose are
+		// handled by the recursion
+		covered := s
+		for _, span := range spans {
+			if span.Start < covered || span.End > e {
+				continue
+			}
+			covered = span.End
+			if span.End-span.Start > 2 {
+				sentences = t.extractNested(runes, offsets, spans, span.Start+1, span.End-1, sentence, sentences)
+			}
+		}
+	}
+	return sentences
+}
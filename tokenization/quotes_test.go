@@ -0,0 +1,125 @@
+package tokenization
+
+import "testing"
+
+func TestQuotedSpans(t *testing.T) {
+	tests := []struct {
+		text string
+		want [][2]int
+	}{
+		{`a «b (c) d» e`, [][2]int{{2, 11}, {5, 8}}},
+		{`“x ‘y’ z”`, [][2]int{{0, 9}, {3, 6}}},
+		{`"a" "b"`, [][2]int{{0, 3}, {4, 7}}},
+		// the apostrophe closes nothing and the open bracket is ignored
+		{`Ankara’da (bir [iki) üç`, [][2]int{{10, 20}}},
+		{`a) b`, [][2]int{}},
+		// an apostrophe inside single quotes does not close them
+		{`‘Ankara’da kaldım.’ dedi.`, [][2]int{{0, 19}}},
+	}
+	for _, tt := range tests {
+		spans := quotedSpans([]rune(tt.text))
+		if len(spans) != len(tt.want) {
+			t.Errorf("quotedSpans(%q) = %v, want %v", tt.text, spans, tt.want)
+			continue
+		}
+		for i, span := range spans {
+			if span.Start != tt.want[i][0] || span.End != tt.want[i][1] {
+				t.Errorf("quotedSpans(%q)[%d] = %d-%d, want %v", tt.text, i, span.Start, span.End, tt.want[i])
+			}
+		}
+	}
+}
+
+func TestQuotesInSentence(t *testing.T) {
+	extractor, err := NewTurkishSentenceExtractor(false, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	extractor.QuoteMode = QuotesInSentence
+	tests := []struct {
+		paragraph string
+		want      []string
+	}{
+		{
+			"Ali «Geldim. Gördüm. Yendim.» dedi. Herkes güldü.",
+			[]string{"Ali «Geldim. Gördüm. Yendim.» dedi.", "Herkes güldü."},
+		},
+		{
+			"Madde şöyle: “Herkes eşittir. Kimseye ayrıcalık tanınamaz.” Bu hüküm açıktır.",
+			[]string{"Madde şöyle: “Herkes eşittir. Kimseye ayrıcalık tanınamaz.”", "Bu hüküm açıktır."},
+		},
+		{
+			"Karar bozuldu (bkz. Yargıtay kararı. Ayrıca [not. Ek 2.] bakınız). Dosya iade edildi.",
+			[]string{"Karar bozuldu (bkz. Yargıtay kararı. Ayrıca [not. Ek 2.] bakınız).", "Dosya iade edildi."},
+		},
+		{
+			"O ‘Bitti.’ dedi. Ankara’da kaldık. Sonra döndük.",
+			[]string{"O ‘Bitti.’ dedi.", "Ankara’da kaldık.", "Sonra döndük."},
+		},
+		{
+			"‘Ankara’da kaldım. Sonra döndüm.’ dedi. Herkes güldü.",
+			[]string{"‘Ankara’da kaldım. Sonra döndüm.’ dedi.", "Herkes güldü."},
+		},
+		{
+			`Kanun (madde 3: "Hüküm. Uygulanır.") açıktır. Son.`,
+			[]string{`Kanun (madde 3: "Hüküm. Uygulanır.") açıktır.`, "Son."},
+		},
+	}
+	for _, tt := range tests {
+		got := extractor.FromParagraph(tt.paragraph)
+		if len(got) != len(tt.want) {
+			t.Errorf("FromParagraph(%q) = %q, want %q", tt.paragraph, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("FromParagraph(%q) = %q, want %q", tt.paragraph, got, tt.want)
+				break
+			}
+		}
+	}
+}
+
+func TestQuotesNested(t *testing.T) {
+	extractor, err := NewTurkishSentenceExtractor(false, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	extractor.QuoteMode = QuotesNested
+	paragraph := "Ali «Geldim. Ona (yani Veli'ye. Hemen.) söyledim.» dedi. Herkes güldü."
+	want := []struct {
+		text   string
+		parent int
+	}{
+		{"Ali «Geldim. Ona (yani Veli'ye. Hemen.) söyledim.» dedi.", -1},
+		{"Geldim.", 0},
+		{"Ona (yani Veli'ye. Hemen.) söyledim.", 0},
+		{"yani Veli'ye.", 2},
+		{"Hemen.", 2},
+		{"Herkes güldü.", -1},
+	}
+
+	sentences := extractor.Extract(paragraph)
+	if len(sentences) != len(want) {
+		for _, s := range sentences {
+			t.Logf("%q", s.Text)
+		}
+		t.Fatalf("%d sentences, want %d", len(sentences), len(want))
+	}
+	runes := []rune(paragraph)
+	for i, s := range sentences {
+		if s.Text != want[i].text {
+			t.Errorf("sentence %d = %q, want %q", i, s.Text, want[i].text)
+		}
+		if got := string(runes[s.Start:s.End]); got != s.Text {
+			t.Errorf("runes of sentence %d are %q", i, got)
+		}
+		var parent *Sentence
+		if want[i].parent >= 0 {
+			parent = sentences[want[i].parent]
+		}
+		if s.Parent != parent {
+			t.Errorf("parent of sentence %d = %v, want %v", i, s.Parent, parent)
+		}
+	}
+}
@@ -0,0 +1,300 @@
+package tokenization
+
+import (
+	_ "embed"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Sentence boundaries are found like the Java implementation does: every
+// boundary character is a candidate, rules drop obvious non-boundaries and a
+// binary averaged perceptron scores the features of the rest. A candidate
+// with a positive score ends a sentence.
+
+//go:embed data/sentence-boundary-weights.tsv
+var defaultWeightsData string
+
+var (
+	defaultWeights     map[string]float64
+	defaultWeightsOnce sync.Once
+)
+
+// DefaultWeights returns the sentence boundary model embedded in the
+// package. It is trained on data/sentence-boundary-train.txt.
+func DefaultWeights() map[string]float64 {
+	defaultWeightsOnce.Do(func() {
+		weights, err := ReadWeights(strings.NewReader(defaultWeightsData))
+		if err != nil {
+			panic("tokenization: embedded sentence boundary weights: " + err.Error())
+		}
+		defaultWeights = weights
+	})
+	// copy so that callers cannot change the shared model
+	weights := make(map[string]float64, len(defaultWeights))
+	for k, v := range defaultWeights {
+		weights[k] = v
+	}
+	return weights
+}
+
+// openingMarks may precede the word before a boundary
+const openingMarks = "\"“‘'«(["
+
+// closingMarks may follow a boundary character and belong to the sentence
+// it ends, as in `"Gel!"`
+var closingMarks = map[rune]bool{'"': true, '”': true, '’': true, '»': true, ')': true, ']': true}
+
+// boundaryData holds the context of a candidate boundary character
+type boundaryData struct {
+	currentChar             rune
+	previousLetter          rune
+	nextLetter              rune
+	previousTwoLetters      string
+	nextTwoLetters          string
+	leftChunk               string
+	leftChunkUntilBoundary  string
+	rightChunk              string
+	rightChunkUntilBoundary string
+	currentWord             string
+	nextWord                string
+}
+
+// newBoundaryData collects the context of the rune at pos. Missing letters
+// at the ends of the text are '_' and white space letters are ' ', which
+// keeps features on one line of a weights file.
+func newBoundaryData(runes []rune, pos int) *boundaryData {
+	at := func(i int) rune {
+		if i < 0 || i >= len(runes) {
+			return '_'
+		}
+		if unicode.IsSpace(runes[i]) {
+			return ' '
+		}
+		return runes[i]
+	}
+	b := &boundaryData{
+		currentChar:        runes[pos],
+		previousLetter:     at(pos - 1),
+		nextLetter:         at(pos + 1),
+		previousTwoLetters: string([]rune{at(pos - 2), at(pos - 1)}),
+		nextTwoLetters:     string([]rune{at(pos + 1), at(pos + 2)}),
+	}
+
+	left := pos
+	for left > 0 && !unicode.IsSpace(runes[left-1]) {
+		left--
+	}
+	b.leftChunk = string(runes[left:pos])
+	left = pos
+	for left > 0 && !unicode.IsSpace(runes[left-1]) && !boundaryChars[runes[left-1]] {
+		left--
+	}
+	b.leftChunkUntilBoundary = string(runes[left:pos])
+
+	right := pos + 1
+	for right < len(runes) && !unicode.IsSpace(runes[right]) {
+		right++
+	}
+	b.rightChunk = string(runes[pos+1 : right])
+	end := pos + 1
+	for end < len(runes) && !unicode.IsSpace(runes[end]) && !boundaryChars[runes[end]] {
+		end++
+	}
+	b.rightChunkUntilBoundary = string(runes[pos+1 : end])
+	b.currentWord = b.leftChunk + string(b.currentChar) + b.rightChunk
+
+	// the next word is the chunk after the following spaces
+	for right < len(runes) && unicode.IsSpace(runes[right]) {
+		right++
+	}
+	end = right
+	for end < len(runes) && !unicode.IsSpace(runes[end]) {
+		end++
+	}
+	b.nextWord = string(runes[right:end])
+	return b
+}
+
+// nonBoundary reports whether the candidate cannot be a boundary: it ends a
+// single letter like "A.", it is followed by an apostrophe or another
+// boundary character, it is part of a web address or it ends an
+// abbreviation that cannot end a sentence, like "Dr."
+func (b *boundaryData) nonBoundary(registry *AbbreviationRegistry) bool {
+	if a, ok := b.abbreviation(registry); ok && !a.EndsSentence {
+		return true
+	}
+	return len([]rune(b.leftChunkUntilBoundary)) == 1 ||
+		b.nextLetter == '\'' ||
+		boundaryChars[b.nextLetter] ||
+		PotentialWebsite(b.currentWord)
+}
+
+// abbreviation returns the abbreviation the candidate dot ends, written as
+// in registry
+func (b *boundaryData) abbreviation(registry *AbbreviationRegistry) (AbbreviationInfo, bool) {
+	if b.currentChar != '.' {
+		return AbbreviationInfo{}, false
+	}
+	if a, ok := registry.exact(strings.TrimLeft(b.leftChunkUntilBoundary, openingMarks) + "."); ok {
+		return a, true
+	}
+	return registry.exact(strings.TrimLeft(b.leftChunk, openingMarks) + ".")
+}
+
+// features returns the perceptron features of the candidate with the
+// abbreviations of registry
+func (b *boundaryData) features(registry *AbbreviationRegistry) []string {
+	left := strings.TrimLeft(b.leftChunkUntilBoundary, openingMarks)
+	_, dotted := b.abbreviation(registry)
+	// the next word starts in the right chunk unless it holds only marks,
+	// like the closing quote of `“Gel.” Gittim.`
+	next := firstMetaChar(b.rightChunk)
+	if next == "_" || next == "-" {
+		next = firstMetaChar(b.nextWord)
+	}
+	shape := chunkShape(left)
+
+	return []string{
+		"1:" + strconv.FormatBool(unicode.IsUpper(b.previousLetter)),
+		"1b:" + strconv.FormatBool(unicode.IsSpace(b.nextLetter)),
+		"1a:" + string(b.previousLetter),
+		"1c:" + string(b.nextLetter),
+		"2p:" + b.previousTwoLetters,
+		"2n:" + b.nextTwoLetters,
+		"3:" + string(b.currentChar),
+		"7:" + strconv.FormatBool(startsUpper(b.currentWord)),
+		"7b:" + strconv.FormatBool(startsUpper(b.rightChunk)),
+		"8:" + turkishLower(left),
+		"9:" + shape,
+		"10:" + chunkShape(b.rightChunkUntilBoundary),
+		"11:" + next,
+		"12:" + strconv.Itoa(min(len([]rune(left)), 5)),
+		"14:" + strconv.FormatBool(dotted),
+		"15:" + strconv.FormatBool(registry.listed(left)),
+		"16:" + strconv.FormatBool(dotted) + "|" + next,
+		"17:" + shape + "|" + next,
+	}
+}
+
+func startsUpper(s string) bool {
+	for _, r := range s {
+		return unicode.IsUpper(r)
+	}
+	return false
+}
+
+// firstMetaChar returns the meta character of the first letter or digit of
+// s, skipping opening quotes and brackets. It is "_" for the end of text.
+func firstMetaChar(s string) string {
+	if s == "" {
+		return "_"
+	}
+	for _, r := range s {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return GetMetaChar(string(r))
+		}
+	}
+	return "-"
+}
+
+// chunkShape returns the meta characters of s, keeping only the first and
+// last two of long chunks
+func chunkShape(s string) string {
+	runes := []rune(s)
+	if len(runes) == 0 {
+		return "_"
+	}
+	var meta strings.Builder
+	for i, r := range runes {
+		if len(runes) > 4 && i == 2 {
+			meta.WriteRune('~')
+		}
+		if len(runes) > 4 && i >= 2 && i < len(runes)-2 {
+			continue
+		}
+		meta.WriteString(GetMetaChar(string(r)))
+	}
+	return meta.String()
+}
+
+// doubleQuoteSpans returns the spans between pairs of double quotes
+func doubleQuoteSpans(runes []rune) []*Span {
+	spans := make([]*Span, 0)
+	start := -1
+	for i, r := range runes {
+		if !doubleQuotes[r] {
+			continue
+		}
+		if start < 0 {
+			start = i
+		} else {
+			spans = append(spans, &Span{Start: start, End: i + 1})
+			start = -1
+		}
+	}
+	return spans
+}
+
+// nestedSpans returns the quoted spans of runes that sentences do not end
+// in, or nil if the quote mode is QuotesIgnored
+func (t *TurkishSentenceExtractor) nestedSpans(runes []rune) []*Span {
+	if t.QuoteMode == QuotesIgnored {
+		return nil
+	}
+	return quotedSpans(runes)
+}
+
+// boundaryCandidates returns the candidate boundaries in runes that pass
+// the rules, with their context. nestedSpans are the spans returned by
+// t.nestedSpans.
+func (t *TurkishSentenceExtractor) boundaryCandidates(runes []rune, nestedSpans []*Span) ([]int, []*boundaryData) {
+	var quoteSpans []*Span
+	if t.QuoteMode == QuotesIgnored && t.DoNotSplitInDoubleQuotes {
+		quoteSpans = doubleQuoteSpans(runes)
+	}
+
+	positions := make([]int, 0)
+	data := make([]*boundaryData, 0)
+candidates:
+	for j, ch := range runes {
+		if !boundaryChars[ch] {
+			continue
+		}
+		for _, span := range quoteSpans {
+			if span.InSpan(j) {
+				continue candidates
+			}
+		}
+		if insideQuotes(runes, nestedSpans, j) {
+			continue
+		}
+		b := newBoundaryData(runes, j)
+		if b.nonBoundary(t.Abbreviations) {
+			continue
+		}
+		positions = append(positions, j)
+		data = append(data, b)
+	}
+	return positions, data
+}
+
+// score returns the sum of the weights of features
+func (t *TurkishSentenceExtractor) score(features []string) float64 {
+	score := 0.0
+	for _, feature := range features {
+		score += t.GetWeight(feature)
+	}
+	return score
+}
+
+// sentenceEnd returns the end of a sentence whose boundary character is at
+// pos, after the closing marks that follow it
+func sentenceEnd(runes []rune, pos int) int {
+	end := pos + 1
+	for end < len(runes) && closingMarks[runes[end]] {
+		end++
+	}
+	return end
+}
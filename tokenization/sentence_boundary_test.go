@@ -0,0 +1,162 @@
+package tokenization
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var updateWeights = flag.Bool("update", false, "retrain data/sentence-boundary-weights.tsv")
+
+// TestDefaultWeights checks that the embedded model is the one trained on
+// the embedded training data. Run with -update after changing features or
+// data.
+func TestDefaultWeights(t *testing.T) {
+	file, err := os.Open("data/sentence-boundary-train.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	extractor, err := NewTurkishSentenceExtractor(false, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	weights, err := extractor.Train(file, DefaultBoundaryEpochs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var trained bytes.Buffer
+	if err := WriteWeights(&trained, weights); err != nil {
+		t.Fatal(err)
+	}
+
+	if *updateWeights {
+		if err := os.WriteFile("data/sentence-boundary-weights.tsv", trained.Bytes(), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	if trained.String() != defaultWeightsData {
+		t.Fatal("data/sentence-boundary-weights.tsv is stale, run go test ./tokenization -run TestDefaultWeights -update")
+	}
+}
+
+// TestEvaluateHeldOut evaluates the embedded model on sentences it is not
+// trained on
+func TestEvaluateHeldOut(t *testing.T) {
+	file, err := os.Open("data/sentence-boundary-test.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	extractor, err := NewTurkishSentenceExtractor(false, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	evaluation, err := extractor.Evaluate(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log(evaluation)
+	if evaluation.Precision() < 0.95 || evaluation.Recall() < 0.95 {
+		t.Errorf("held out %v", evaluation)
+	}
+}
+
+func TestTrainAndSaveWeights(t *testing.T) {
+	extractor, err := NewTurkishSentenceExtractor(false, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	train := "Av. Ali geldi.\nBen gittim.\n\nDr. Can baktı.\nSonra çıktı.\n"
+	weights, err := extractor.Train(strings.NewReader(train), 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(t.TempDir(), "weights.csv")
+	if err := SaveWeightsToCSV(path, weights); err != nil {
+		t.Fatal(err)
+	}
+	trained, err := NewTurkishSentenceExtractor(false, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	evaluation, err := trained.Evaluate(strings.NewReader(train))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if evaluation != (BoundaryEvaluation{TruePositives: 2}) {
+		t.Errorf("Evaluate() = %v", evaluation)
+	}
+}
+
+func TestSentenceBoundaries(t *testing.T) {
+	extractor, err := NewTurkishSentenceExtractor(false, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		paragraph string
+		want      []string
+	}{
+		{
+			"Av. Kemal Yıldız dilekçeyi verdi. Dr. Selin Aydın ise raporu hazırladı.",
+			[]string{"Av. Kemal Yıldız dilekçeyi verdi.", "Dr. Selin Aydın ise raporu hazırladı."},
+		},
+		{
+			"Yargıtay 13. Hukuk Dairesi dosyayı inceledi. Karar onandı.",
+			[]string{"Yargıtay 13. Hukuk Dairesi dosyayı inceledi.", "Karar onandı."},
+		},
+		{
+			"Elma, armut vs. aldık. Sonra eve döndük.",
+			[]string{"Elma, armut vs. aldık.", "Sonra eve döndük."},
+		},
+		{
+			"Toplantı 2019 yılında yapıldı. Gelecek yıl 3. kez yapılacak!",
+			[]string{"Toplantı 2019 yılında yapıldı.", "Gelecek yıl 3. kez yapılacak!"},
+		},
+		{
+			"Fiyat 12.5 TL oldu. Ne dersin? Bence pahalı.",
+			[]string{"Fiyat 12.5 TL oldu.", "Ne dersin?", "Bence pahalı."},
+		},
+		{
+			"“Gel!” dedi. Gittim.",
+			[]string{"“Gel!” dedi.", "Gittim."},
+		},
+		{
+			"Siteye www.ornek.com.tr adresinden girin. Kayıt ücretsiz.",
+			[]string{"Siteye www.ornek.com.tr adresinden girin.", "Kayıt ücretsiz."},
+		},
+	}
+	for _, tt := range tests {
+		got := extractor.FromParagraph(tt.paragraph)
+		if len(got) != len(tt.want) {
+			t.Errorf("FromParagraph(%q) = %q, want %q", tt.paragraph, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("FromParagraph(%q) = %q, want %q", tt.paragraph, got, tt.want)
+				break
+			}
+		}
+	}
+}
+
+func TestDoNotSplitInDoubleQuotes(t *testing.T) {
+	extractor, err := NewTurkishSentenceExtractor(true, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := extractor.FromParagraph(`Ali "Geldim. Gördüm." dedi. Herkes güldü.`)
+	want := []string{`Ali "Geldim. Gördüm." dedi.`, "Herkes güldü."}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("FromParagraph() = %q, want %q", got, want)
+	}
+}
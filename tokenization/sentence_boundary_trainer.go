@@ -0,0 +1,251 @@
+package tokenization
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DefaultBoundaryEpochs is the number of passes over the training data used
+// for the embedded model
+const DefaultBoundaryEpochs = 10
+
+// Training and evaluation data holds one sentence per line. Paragraphs are
+// separated by empty lines and their sentences are joined with a space, so
+// boundaries are learned in running text.
+
+// BoundaryEvaluation counts the sentence boundaries found in evaluation
+// data. Boundaries are compared inside paragraphs, paragraph ends are not
+// counted.
+type BoundaryEvaluation struct {
+	TruePositives  int
+	FalsePositives int
+	FalseNegatives int
+}
+
+// Precision returns the ratio of found boundaries that are correct
+func (e BoundaryEvaluation) Precision() float64 {
+	if e.TruePositives+e.FalsePositives == 0 {
+		return 0
+	}
+	return float64(e.TruePositives) / float64(e.TruePositives+e.FalsePositives)
+}
+
+// Recall returns the ratio of correct boundaries that are found
+func (e BoundaryEvaluation) Recall() float64 {
+	if e.TruePositives+e.FalseNegatives == 0 {
+		return 0
+	}
+	return float64(e.TruePositives) / float64(e.TruePositives+e.FalseNegatives)
+}
+
+// F1 returns the harmonic mean of precision and recall
+func (e BoundaryEvaluation) F1() float64 {
+	p, r := e.Precision(), e.Recall()
+	if p+r == 0 {
+		return 0
+	}
+	return 2 * p * r / (p + r)
+}
+
+func (e BoundaryEvaluation) String() string {
+	return fmt.Sprintf("precision %.4f recall %.4f f1 %.4f (tp %d fp %d fn %d)",
+		e.Precision(), e.Recall(), e.F1(), e.TruePositives, e.FalsePositives, e.FalseNegatives)
+}
+
+// readGoldParagraphs reads paragraphs of gold sentences
+func readGoldParagraphs(r io.Reader) ([][]string, error) {
+	paragraphs := make([][]string, 0)
+	paragraph := make([]string, 0)
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			if len(paragraph) > 0 {
+				paragraphs = append(paragraphs, paragraph)
+				paragraph = make([]string, 0)
+			}
+			continue
+		}
+		paragraph = append(paragraph, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("tokenization: read sentence boundary data: %w", err)
+	}
+	if len(paragraph) > 0 {
+		paragraphs = append(paragraphs, paragraph)
+	}
+	return paragraphs, nil
+}
+
+// goldEnds returns the joined text of paragraph and the rune offsets where
+// its sentences end, the last one excluded
+func goldEnds(paragraph []string) ([]rune, []int) {
+	runes := []rune(strings.Join(paragraph, " "))
+	ends := make([]int, 0, len(paragraph)-1)
+	end := 0
+	for _, sentence := range paragraph[:len(paragraph)-1] {
+		end += len([]rune(sentence))
+		ends = append(ends, end)
+		end++
+	}
+	return runes, ends
+}
+
+// boundaryExample is a candidate boundary of training data
+type boundaryExample struct {
+	features []string
+	boundary bool
+}
+
+// readBoundaryExamples reads training data and turns every candidate
+// boundary into an example. A candidate is a boundary if a gold sentence
+// ends with it, or with it and closing marks.
+func (t *TurkishSentenceExtractor) readBoundaryExamples(r io.Reader) ([]boundaryExample, error) {
+	paragraphs, err := readGoldParagraphs(r)
+	if err != nil {
+		return nil, err
+	}
+
+	examples := make([]boundaryExample, 0)
+	for _, paragraph := range paragraphs {
+		runes, ends := goldEnds(paragraph)
+		boundaries := make(map[int]bool)
+		for _, end := range append(ends, len(runes)) {
+			pos := end - 1
+			for pos > 0 && closingMarks[runes[pos]] {
+				pos--
+			}
+			boundaries[pos] = true
+		}
+		positions, data := t.boundaryCandidates(runes, t.nestedSpans(runes))
+		for i, pos := range positions {
+			examples = append(examples, boundaryExample{
+				features: data[i].features(t.Abbreviations),
+				boundary: boundaries[pos],
+			})
+		}
+	}
+	return examples, nil
+}
+
+// Train trains sentence boundary weights on the gold sentences read from r
+// with a binary averaged perceptron. Features are extracted as
+// ExtractToSpans extracts them, with the abbreviations and quote setting of
+// t. The weights of t are not changed. The same data always gives the same
+// weights.
+func (t *TurkishSentenceExtractor) Train(r io.Reader, epochs int) (map[string]float64, error) {
+	examples, err := t.readBoundaryExamples(r)
+	if err != nil {
+		return nil, err
+	}
+	return trainBoundaryWeights(examples, epochs), nil
+}
+
+// trainBoundaryWeights trains a binary averaged perceptron on examples and
+// returns the averaged weights. Examples are visited in order.
+func trainBoundaryWeights(examples []boundaryExample, epochs int) map[string]float64 {
+	weights := make(map[string]float64)
+	// updates accumulates every update scaled by the time it was made
+	updates := make(map[string]float64)
+	count := 1.0
+
+	for epoch := 0; epoch < epochs; epoch++ {
+		for _, example := range examples {
+			score := 0.0
+			for _, feature := range example.features {
+				score += weights[feature]
+			}
+			if (score > 0) != example.boundary {
+				y := -1.0
+				if example.boundary {
+					y = 1.0
+				}
+				for _, feature := range example.features {
+					weights[feature] += y
+					updates[feature] += y * count
+				}
+			}
+			count++
+		}
+	}
+
+	averaged := make(map[string]float64, len(weights))
+	for feature, weight := range weights {
+		if w := weight - updates[feature]/count; w != 0 {
+			averaged[feature] = w
+		}
+	}
+	return averaged
+}
+
+// Evaluate splits the paragraphs read from r with the weights of t and
+// compares the sentence ends with the gold ones
+func (t *TurkishSentenceExtractor) Evaluate(r io.Reader) (BoundaryEvaluation, error) {
+	paragraphs, err := readGoldParagraphs(r)
+	if err != nil {
+		return BoundaryEvaluation{}, err
+	}
+
+	var evaluation BoundaryEvaluation
+	for _, paragraph := range paragraphs {
+		runes, ends := goldEnds(paragraph)
+		gold := make(map[int]bool, len(ends))
+		for _, end := range ends {
+			gold[end] = true
+		}
+		spans := t.ExtractToSpans(string(runes))
+		for _, span := range spans[:len(spans)-1] {
+			if gold[span.End] {
+				evaluation.TruePositives++
+				delete(gold, span.End)
+			} else {
+				evaluation.FalsePositives++
+			}
+		}
+		evaluation.FalseNegatives += len(gold)
+	}
+	return evaluation, nil
+}
+
+// WriteWeights writes weights as tab separated feature/weight pairs sorted
+// by feature, the format ReadWeights reads. Weights are rounded to four
+// decimals and the ones rounded to zero are left out.
+func WriteWeights(w io.Writer, weights map[string]float64) error {
+	features := make([]string, 0, len(weights))
+	for feature := range weights {
+		features = append(features, feature)
+	}
+	sort.Strings(features)
+
+	out := bufio.NewWriter(w)
+	for _, feature := range features {
+		value := strconv.FormatFloat(weights[feature], 'f', 4, 64)
+		if value == "0.0000" || value == "-0.0000" {
+			continue
+		}
+		if _, err := fmt.Fprintf(out, "%s\t%s\n", feature, value); err != nil {
+			return err
+		}
+	}
+	return out.Flush()
+}
+
+// SaveWeightsToCSV writes weights to path in the format LoadWeightsFromCSV
+// reads
+func SaveWeightsToCSV(path string, weights map[string]float64) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := WriteWeights(file, weights); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
@@ -2,13 +2,19 @@ package tokenization
 
 import "fmt"
 
-// Span represents specified chunks of a string
+// Span represents specified chunks of a string as half-open intervals in
+// runes, bytes and UTF-16 code units. Start and End are rune offsets.
 type Span struct {
-	Start int
-	End   int
+	Start      int
+	End        int
+	StartByte  int
+	EndByte    int
+	StartUTF16 int
+	EndUTF16   int
 }
 
-// NewSpan creates a new Span
+// NewSpan creates a new Span from rune offsets. Byte and UTF-16 offsets
+// depend on the text and are left zero, OffsetMap.Span sets all of them.
 func NewSpan(start, end int) (*Span, error) {
 	if start < 0 || end < 0 {
 		return nil, fmt.Errorf("span start and end values cannot be negative")
@@ -22,7 +28,7 @@ func NewSpan(start, end int) (*Span, error) {
 	}, nil
 }
 
-// GetLength returns the length of the span
+// GetLength returns the length of the span in runes
 func (s *Span) GetLength() int {
 	return s.End - s.Start
 }
@@ -34,18 +40,33 @@ func (s *Span) MiddleValue() int {
 
 // GetSubString returns the substring from the given string
 func (s *Span) GetSubString(str string) string {
-	return str[s.Start:s.End]
+	return string([]rune(str)[s.Start:s.End])
 }
 
-// InSpan returns true if the index is within the span
+// InSpan returns true if the rune index is within the span
 func (s *Span) InSpan(i int) bool {
 	return s.Start <= i && i < s.End
 }
 
-// Copy returns a copy of the span with an offset
+// Copy returns a copy of the span with an offset in runes. Byte and UTF-16
+// offsets are left zero, use CopyWithin to shift all of them.
 func (s *Span) Copy(offset int) *Span {
 	return &Span{
 		Start: offset + s.Start,
 		End:   offset + s.End,
 	}
 }
+
+// CopyWithin returns a copy of the span shifted by the start offsets of
+// base. It turns a span relative to base into one relative to the text of
+// base.
+func (s *Span) CopyWithin(base *Span) *Span {
+	return &Span{
+		Start:      base.Start + s.Start,
+		End:        base.Start + s.End,
+		StartByte:  base.StartByte + s.StartByte,
+		EndByte:    base.StartByte + s.EndByte,
+		StartUTF16: base.StartUTF16 + s.StartUTF16,
+		EndUTF16:   base.StartUTF16 + s.EndUTF16,
+	}
+}
@@ -0,0 +1,227 @@
+package tokenization
+
+import (
+	"bufio"
+	"io"
+	"iter"
+	"unicode"
+)
+
+// DefaultStreamBufferSize is the number of runes a stream buffers by default
+const DefaultStreamBufferSize = 64 * 1024
+
+// runeBuffer holds runes read from a reader together with their sizes in
+// bytes and the position of the first buffered rune in the stream
+type runeBuffer struct {
+	reader *bufio.Reader
+	runes  []rune
+	sizes  []uint8
+	start  position
+	eof    bool
+	err    error
+}
+
+func newRuneBuffer(r io.Reader) runeBuffer {
+	return runeBuffer{reader: bufio.NewReader(r)}
+}
+
+// fill reads runes until the buffer holds size runes or the input ends
+func (b *runeBuffer) fill(size int) {
+	for len(b.runes) < size && !b.eof {
+		r, n, err := b.reader.ReadRune()
+		if err != nil {
+			b.eof = true
+			if err != io.EOF {
+				b.err = err
+			}
+			return
+		}
+		b.runes = append(b.runes, r)
+		b.sizes = append(b.sizes, uint8(n))
+	}
+}
+
+// positions returns the stream positions of the buffered runes at
+// increasing indexes
+func (b *runeBuffer) positions() *positions {
+	return &positions{buf: b, p: b.start}
+}
+
+// discard drops the first n buffered runes
+func (b *runeBuffer) discard(n int) {
+	b.start = b.positions().at(n)
+	b.runes = b.runes[:copy(b.runes, b.runes[n:])]
+	b.sizes = b.sizes[:copy(b.sizes, b.sizes[n:])]
+}
+
+// TokenStream tokenizes text read from a reader. Like bufio.Scanner, it is
+// iterated once and Err reports the read error that stopped it, if any.
+type TokenStream struct {
+	// BufferSize is the number of runes buffered while tokenizing
+	BufferSize int
+
+	tokenizer *TurkishTokenizer
+	buf       runeBuffer
+}
+
+// Stream returns a stream of the tokens of text read from r. Token offsets
+// are relative to the start of the stream.
+func (t *TurkishTokenizer) Stream(r io.Reader) *TokenStream {
+	return &TokenStream{
+		BufferSize: DefaultStreamBufferSize,
+		tokenizer:  t,
+		buf:        newRuneBuffer(r),
+	}
+}
+
+// All returns an iterator over the tokens of the stream. Tokens never
+// contain spaces, tabs or line breaks, so the buffered text is tokenized up
+// to its last such rune and tokens crossing the buffer end are completed
+// with the next read. Only runs of more than BufferSize runes without them
+// may be split.
+func (s *TokenStream) All() iter.Seq[*Token] {
+	return func(yield func(*Token) bool) {
+		b := &s.buf
+		for {
+			b.fill(max(s.BufferSize, 1))
+			if len(b.runes) == 0 {
+				return
+			}
+			n := len(b.runes)
+			if !b.eof {
+				n = s.cut()
+			}
+			if !s.tokenizer.scan(b.runes[:n], b.sizes[:n], b.start, yield) {
+				return
+			}
+			b.discard(n)
+		}
+	}
+}
+
+// cut returns the number of buffered runes that can be tokenized without
+// reading more
+func (s *TokenStream) cut() int {
+	runes := s.buf.runes
+	for i := len(runes) - 1; i >= 0; i-- {
+		switch runes[i] {
+		case ' ', '\t', '\n', '\r':
+			return i + 1
+		}
+	}
+	// no break in a full buffer, keep the last token for the next read
+	l := &lexer{runes: runes, abbreviations: s.tokenizer.abbreviations}
+	last := 0
+	for pos := 0; pos < len(runes); {
+		end, _ := l.next(pos)
+		last, pos = pos, max(end, pos+1)
+	}
+	if last == 0 {
+		return len(runes)
+	}
+	return last
+}
+
+// Err returns the first non-EOF error encountered while reading
+func (s *TokenStream) Err() error {
+	return s.buf.err
+}
+
+// Sentence is a sentence with the offsets of its text. Sentences inside
+// quotes or brackets returned by Extract link to the enclosing sentence with
+// Parent, it is nil for other sentences.
+type Sentence struct {
+	Text string
+	Span
+	Parent *Sentence
+}
+
+// SentenceStream extracts sentences from text read from a reader. Like
+// bufio.Scanner, it is iterated once and Err reports the read error that
+// stopped it, if any.
+type SentenceStream struct {
+	// BufferSize is the number of runes buffered while extracting sentences
+	BufferSize int
+
+	extractor *TurkishSentenceExtractor
+	buf       runeBuffer
+}
+
+// Stream returns a stream of the sentences of text read from r
+func (t *TurkishSentenceExtractor) Stream(r io.Reader) *SentenceStream {
+	return &SentenceStream{
+		BufferSize: DefaultStreamBufferSize,
+		extractor:  t,
+		buf:        newRuneBuffer(r),
+	}
+}
+
+// All returns an iterator over the sentences of the stream. The last
+// sentence in the buffer is kept until more text is read, unless it fills
+// the whole buffer.
+func (s *SentenceStream) All() iter.Seq[*Sentence] {
+	return func(yield func(*Sentence) bool) {
+		b := &s.buf
+		for {
+			b.fill(max(s.BufferSize, 1))
+			if len(b.runes) == 0 {
+				return
+			}
+			spans := s.extractor.ExtractToSpans(string(b.runes))
+			n := len(b.runes)
+			if !b.eof && len(spans) > 1 {
+				n = spans[len(spans)-1].Start
+				spans = spans[:len(spans)-1]
+			}
+			offsets := b.positions()
+			for _, span := range spans {
+				sentence := s.sentence(span.Start, span.End, offsets)
+				if sentence != nil && !yield(sentence) {
+					return
+				}
+			}
+			b.discard(n)
+		}
+	}
+}
+
+// positions computes stream positions of buffered runes at increasing
+// indexes
+type positions struct {
+	buf *runeBuffer
+	i   int
+	p   position
+}
+
+func (o *positions) at(i int) position {
+	for ; o.i < i; o.i++ {
+		o.p.rune++
+		o.p.byte += int(o.buf.sizes[o.i])
+		o.p.utf16 += utf16Len(o.buf.runes[o.i])
+	}
+	return o.p
+}
+
+// sentence returns the buffered runes from start to end without surrounding
+// spaces, or nil if there are only spaces
+func (s *SentenceStream) sentence(start, end int, offsets *positions) *Sentence {
+	runes := s.buf.runes
+	for start < end && unicode.IsSpace(runes[start]) {
+		start++
+	}
+	for end > start && unicode.IsSpace(runes[end-1]) {
+		end--
+	}
+	if start == end {
+		return nil
+	}
+	return &Sentence{
+		Text: string(runes[start:end]),
+		Span: spanBetween(offsets.at(start), offsets.at(end)),
+	}
+}
+
+// Err returns the first non-EOF error encountered while reading
+func (s *SentenceStream) Err() error {
+	return s.buf.err
+}
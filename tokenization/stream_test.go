@@ -0,0 +1,93 @@
+package tokenization
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestTokenStream(t *testing.T) {
+	text := strings.Join(lexerSamples, " ")
+	want := ALL.Tokenize(text)
+	for _, size := range []int{40, 100, DefaultStreamBufferSize} {
+		stream := ALL.Stream(iotest.OneByteReader(strings.NewReader(text)))
+		stream.BufferSize = size
+		var got []*Token
+		for token := range stream.All() {
+			got = append(got, token)
+		}
+		if err := stream.Err(); err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != len(want) {
+			t.Fatalf("buffer %d: %d tokens, want %d", size, len(got), len(want))
+		}
+		for i := range got {
+			if *got[i] != *want[i] {
+				t.Errorf("buffer %d: token %d = %+v, want %+v", size, i, got[i], want[i])
+				break
+			}
+			if content := text[got[i].StartByte:got[i].EndByte]; content != got[i].Content {
+				t.Errorf("buffer %d: bytes of %v are %q", size, got[i], content)
+			}
+		}
+	}
+}
+
+func TestTokenStreamLongRun(t *testing.T) {
+	text := strings.Repeat("a", 25) + "," + strings.Repeat("b", 10)
+	stream := ALL.Stream(strings.NewReader(text))
+	stream.BufferSize = 10
+	var got []string
+	for token := range stream.All() {
+		got = append(got, token.Content)
+	}
+	// runs longer than the buffer are split, tokens after them are not
+	if strings.Join(got, "") != text || got[len(got)-1] != strings.Repeat("b", 10) {
+		t.Errorf("tokens = %q", got)
+	}
+}
+
+func TestTokenStreamStopAndError(t *testing.T) {
+	count := 0
+	for range DEFAULT.Stream(strings.NewReader("bir iki üç dört")).All() {
+		if count++; count == 2 {
+			break
+		}
+	}
+	if count != 2 {
+		t.Errorf("count = %d", count)
+	}
+
+	readErr := errors.New("read failed")
+	stream := DEFAULT.Stream(iotest.DataErrReader(iotest.ErrReader(readErr)))
+	for range stream.All() {
+	}
+	if !errors.Is(stream.Err(), readErr) {
+		t.Errorf("Err() = %v", stream.Err())
+	}
+}
+
+func TestSentenceStream(t *testing.T) {
+	extractor, _ := NewTurkishSentenceExtractor(false, "")
+	text := "Merhaba dünya. Bugün hava çok güzel!  Ne dersin?\nÇıkalım mı… Gidelim.   "
+	want := extractor.FromParagraph(text)
+	for _, size := range []int{25, DefaultStreamBufferSize} {
+		stream := extractor.Stream(iotest.HalfReader(strings.NewReader(text)))
+		stream.BufferSize = size
+		var got []string
+		for sentence := range stream.All() {
+			got = append(got, sentence.Text)
+			if s := text[sentence.StartByte:sentence.EndByte]; s != sentence.Text {
+				t.Errorf("bytes of %q are %q", sentence.Text, s)
+			}
+			if s := string([]rune(text)[sentence.Start:sentence.End]); s != sentence.Text {
+				t.Errorf("runes of %q are %q", sentence.Text, s)
+			}
+		}
+		if strings.Join(got, "|") != strings.Join(want, "|") {
+			t.Errorf("buffer %d: sentences = %q, want %q", size, got, want)
+		}
+	}
+}
@@ -1,6 +1,11 @@
 package tokenization
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kalaomer/zemberek-go/core/turkish"
+)
 
 // TokenType represents the type of a token
 type TokenType int
@@ -28,18 +33,25 @@ const (
 	Emoticon
 	UnknownWord
 	Unknown
+	// Symbol is appended so that the values of the other types do not change
+	Symbol
+
+	// lastTokenType is the last token type, for loops over all types
+	lastTokenType = Symbol
 )
 
-// Token represents a lexical token
+// Token represents a lexical token. Its span holds the offsets of the token
+// in the tokenized text. The normalized form of a HashTag token split by a
+// WordSegmenter holds its words separated by spaces, without the '#'.
 type Token struct {
-	Content    string
-	Type       TokenType
-	Start      int
-	End        int
+	Content string
+	Type    TokenType
+	Span
 	Normalized string
 }
 
-// NewToken creates a new Token
+// NewToken creates a new Token with rune offsets from start to end, end
+// exclusive
 func NewToken(content string, tokenType TokenType, start, end int, normalized ...string) *Token {
 	norm := content
 	if len(normalized) > 0 {
@@ -48,8 +60,7 @@ func NewToken(content string, tokenType TokenType, start, end int, normalized ..
 	return &Token{
 		Content:    content,
 		Type:       tokenType,
-		Start:      start,
-		End:        end,
+		Span:       Span{Start: start, End: end},
 		Normalized: norm,
 	}
 }
@@ -131,6 +142,8 @@ func TokenTypeName(t TokenType) string {
 		return "Emoji"
 	case Emoticon:
 		return "Emoticon"
+	case Symbol:
+		return "Symbol"
 	case UnknownWord:
 		return "UnknownWord"
 	case Unknown:
@@ -140,6 +153,17 @@ func TokenTypeName(t TokenType) string {
 	}
 }
 
+// TokenTypeFromName returns the token type with the given name, the inverse
+// of TokenTypeName
+func TokenTypeFromName(name string) (TokenType, bool) {
+	for t := SpaceTab; t <= lastTokenType; t++ {
+		if TokenTypeName(t) == name {
+			return t, true
+		}
+	}
+	return Unknown, false
+}
+
 // Helper methods for token type checking (matches Java implementation)
 
 // IsNumeral returns true if token is a number type
@@ -147,6 +171,22 @@ func (t *Token) IsNumeral() bool {
 	return t.Type == Number || t.Type == RomanNumeral || t.Type == PercentNumeral
 }
 
+// NumberWords returns Number and PercentNumeral tokens in words, e.g. "24,30"
+// → "yirmi dört virgül otuz" and "%40'a" → "yüzde kırk". Suffixes after an
+// apostrophe are not read. Returns false for other tokens and for numbers
+// that can not be read, like fractions and exponents.
+func (t *Token) NumberWords() (string, bool) {
+	if t.Type != Number && t.Type != PercentNumeral {
+		return "", false
+	}
+	number := turkish.Instance.NormalizeApostrophe(t.Content)
+	if i := strings.IndexByte(number, '\''); i >= 0 {
+		number = number[:i]
+	}
+	words, err := turkish.ReadNumber(number)
+	return words, err == nil
+}
+
 // IsWhiteSpace returns true if token is whitespace
 func (t *Token) IsWhiteSpace() bool {
 	return t.Type == SpaceTab || t.Type == NewLine
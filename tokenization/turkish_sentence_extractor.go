@@ -1,11 +1,11 @@
 package tokenization
 
 import (
+	"fmt"
+	"io/fs"
 	"regexp"
 	"strings"
 	"unicode"
-
-	
 )
 
 var (
@@ -16,88 +16,113 @@ var (
 
 // TurkishSentenceExtractor separates sentences using perceptron model and rule-based approaches
 type TurkishSentenceExtractor struct {
-	*PerceptronSegmenter
-	Weights                      map[string]float64
-	DoNotSplitInDoubleQuotes     bool
-	AbbrSet                      map[string]bool
+	Weights                  map[string]float64
+	DoNotSplitInDoubleQuotes bool
+	// Abbreviations tells which dots end abbreviations and which of them
+	// cannot end a sentence. It is the only abbreviation source of the
+	// extractor; pass the registry of the tokenizer to share domain lists.
+	Abbreviations *AbbreviationRegistry
+	// QuoteMode tells how quotes and brackets affect splitting. Anything
+	// but QuotesIgnored overrides DoNotSplitInDoubleQuotes.
+	QuoteMode QuoteMode
 }
 
-// NewTurkishSentenceExtractor creates a new sentence extractor
+// NewTurkishSentenceExtractor creates a new sentence extractor. An empty
+// weightsPath uses the model embedded in the package, see DefaultWeights.
 func NewTurkishSentenceExtractor(doNotSplitInDoubleQuotes bool, weightsPath string) (*TurkishSentenceExtractor, error) {
-	weights, err := LoadWeightsFromCSV(weightsPath)
+	weights := DefaultWeights()
+	if weightsPath != "" {
+		var err error
+		weights, err = LoadWeightsFromCSV(weightsPath)
+		if err != nil {
+			return nil, fmt.Errorf("tokenization: load sentence boundary weights %s: %w", weightsPath, err)
+		}
+	}
+
+	return &TurkishSentenceExtractor{
+		Weights:                  weights,
+		DoNotSplitInDoubleQuotes: doNotSplitInDoubleQuotes,
+		Abbreviations:            DefaultAbbreviations,
+	}, nil
+}
+
+// NewTurkishSentenceExtractorFS creates a sentence extractor whose weights
+// and abbreviations are read from weightsName and abbreviationsName inside
+// fsys, like "sentence-boundary/weights.csv" and
+// "sentence-boundary/abbreviations.txt" of a resource.Bundle. The
+// abbreviations replace DefaultAbbreviations. A missing file is an error.
+func NewTurkishSentenceExtractorFS(doNotSplitInDoubleQuotes bool, fsys fs.FS, weightsName, abbreviationsName string) (*TurkishSentenceExtractor, error) {
+	weights, err := LoadWeightsFromFS(fsys, weightsName)
 	if err != nil {
-		// Create empty weights if file not found
-		weights = make(map[string]float64)
+		return nil, fmt.Errorf("tokenization: load sentence boundary weights %s: %w", weightsName, err)
+	}
+	abbreviations, err := LoadAbbreviationsFS(fsys, abbreviationsName)
+	if err != nil {
+		return nil, fmt.Errorf("tokenization: load abbreviations %s: %w", abbreviationsName, err)
 	}
 
 	return &TurkishSentenceExtractor{
-		PerceptronSegmenter:      NewPerceptronSegmenter(),
 		Weights:                  weights,
 		DoNotSplitInDoubleQuotes: doNotSplitInDoubleQuotes,
-		AbbrSet:                  LoadAbbreviations(""),
+		Abbreviations:            abbreviations,
 	}, nil
 }
 
-// ExtractToSpans divides paragraph into spans
+// ExtractToSpans divides paragraph into spans with rune, byte and UTF-16
+// offsets. Every boundary character that passes the rules is scored with
+// the model weights and ends a sentence if its score is positive.
 func (t *TurkishSentenceExtractor) ExtractToSpans(paragraph string) []*Span {
+	offsets := NewOffsetMap(paragraph)
 	spans := make([]*Span, 0)
+	for _, bounds := range t.sentenceBounds([]rune(paragraph)) {
+		spans = append(spans, offsets.Span(bounds[0], bounds[1]))
+	}
+	return spans
+}
+
+// sentenceBounds returns the rune offsets where the sentences of runes
+// start and end. Spaces after a boundary are skipped.
+func (t *TurkishSentenceExtractor) sentenceBounds(runes []rune) [][2]int {
+	bounds := make([][2]int, 0)
 	begin := 0
 
-	runes := []rune(paragraph)
-
-	for j, ch := range runes {
-		if boundaryChars[ch] {
-			// Include the boundary character in the sentence
-			end := j + 1
-
-			// Check if it's end of sentence
-			if j < len(runes)-1 {
-				nextChar := runes[j+1]
-				if unicode.IsSpace(nextChar) || unicode.IsUpper(nextChar) {
-					span, _ := NewSpan(begin, end)
-					if span.GetLength() > 0 {
-						spans = append(spans, span)
-					}
-					// Skip spaces after boundary
-					for end < len(runes) && unicode.IsSpace(runes[end]) {
-						end++
-					}
-					begin = end
-				}
-			} else {
-				// Last character is a boundary
-				span, _ := NewSpan(begin, end)
-				if span.GetLength() > 0 {
-					spans = append(spans, span)
-				}
-				begin = end
-			}
+	spans := t.nestedSpans(runes)
+	positions, data := t.boundaryCandidates(runes, spans)
+	for i, j := range positions {
+		if t.score(data[i].features(t.Abbreviations)) <= 0 {
+			continue
 		}
+		if continuesAfterQuote(runes, spans, begin, j) {
+			continue
+		}
+		// Include the boundary character and closing quotes in the sentence
+		end := sentenceEnd(runes, j)
+		if end > begin {
+			bounds = append(bounds, [2]int{begin, end})
+		}
+		// Skip spaces after boundary
+		for end < len(runes) && unicode.IsSpace(runes[end]) {
+			end++
+		}
+		begin = end
 	}
 
 	if begin < len(runes) {
-		span, _ := NewSpan(begin, len(runes))
-		if span.GetLength() > 0 {
-			spans = append(spans, span)
-		}
+		bounds = append(bounds, [2]int{begin, len(runes)})
 	}
 
-	return spans
+	return bounds
 }
 
 // FromParagraph extracts sentences from a paragraph
 func (t *TurkishSentenceExtractor) FromParagraph(paragraph string) []string {
 	spans := t.ExtractToSpans(paragraph)
 	sentences := make([]string, 0)
-	runes := []rune(paragraph)
 
 	for _, span := range spans {
-		// Use rune-based substring since spans are in rune indices
-		if span.Start >= 0 && span.End <= len(runes) {
-			sentence := strings.TrimSpace(string(runes[span.Start:span.End]))
-			if len(sentence) > 0 {
-				sentences = append(sentences, sentence)
-			}
+		sentence := strings.TrimSpace(paragraph[span.StartByte:span.EndByte])
+		if len(sentence) > 0 {
+			sentences = append(sentences, sentence)
 		}
 	}
 
@@ -1,161 +1,112 @@
 package tokenization
 
 import (
-	"regexp"
+	"strings"
+	"unicode/utf8"
 )
 
 // TurkishTokenizer tokenizes Turkish text into typed tokens
 type TurkishTokenizer struct {
 	acceptedTypes map[TokenType]bool
+	segmenter     WordSegmenter
+	abbreviations *AbbreviationRegistry
 }
 
-// Pattern matchers with priority order
-var tokenPatterns = []struct {
-	pattern   *regexp.Regexp
-	tokenType TokenType
-}{
-	// Whitespace (highest priority - single char)
-	{regexp.MustCompile(`^\s`), SpaceTab},
-
-	// URL (before email - can contain @)
-	{urlPattern, URL},
-
-	// Email
-	{emailPattern, Email},
-
-	// Date (before Time - more specific)
-	{datePattern, Date},
-
-	// Time
-	{timePattern, Time},
-
-	// Mention
-	{mentionPattern, Mention},
-
-	// HashTag
-	{hashTagPattern, HashTag},
-
-	// MetaTag
-	{metaTagPattern, MetaTag},
-
-	// Emoticon (before Number - catches "8-)")
-	{emoticonPattern, Emoticon},
-
-	// Percent
-	{percentPattern, PercentNumeral},
-
-	// Number (all formats)
-	{numberExpPattern, Number},
-	{numberFractionPattern, Number},
-	{numberThousandDotPattern, Number},
-	{numberThousandCommaPattern, Number},
-	{numberDecimalPattern, Number},
-	{numberOrdinalPattern, Number},
-	{numberIntegerPattern, Number},
-
-	// Abbreviation with dots
-	{abbreviationWithDotsPattern, AbbreviationWithDots},
-
-	// Roman numeral
-	{romanNumeralPattern, RomanNumeral},
-
-	// Word with symbol
-	{wordWithSymbolPattern, WordWithSymbol},
-
-	// Word alphanumerical
-	{wordAlphanumericalPattern, WordAlphanumerical},
-
-	// Pure word
-	{wordPattern, Word},
-
-	// Punctuation
-	{punctuationPattern, Punctuation},
-
-	// Unknown word
-	{unknownWordPattern, UnknownWord},
+// WordSegmenter splits text written without spaces, like the body of a
+// hashtag, into words. Segment returns nil if it cannot split text.
+// normalization.WordSegmenter implements it with the morphology and a
+// language model.
+type WordSegmenter interface {
+	Segment(text string) []string
 }
 
-// Tokenize tokenizes text and returns array of tokens with types and positions
+// Tokenize tokenizes text and returns array of tokens with types and positions.
+// Text is scanned once by a hand-written lexer; at each position the longest
+// token wins and runes no token starts with become Unknown tokens.
 func (t *TurkishTokenizer) Tokenize(text string) []*Token {
-	tokens := make([]*Token, 0)
-	runes := []rune(text)
-	pos := 0
-
-	for pos < len(runes) {
-		remaining := string(runes[pos:])
-		matched := false
-
-		// Try all patterns and find the longest match
-		var longestMatch struct {
-			text      string
-			tokenType TokenType
-			length    int
-		}
+	runes, sizes := decodeRunes(text)
+	tokens := make([]*Token, 0, len(runes)/4)
+	t.scan(runes, sizes, position{}, func(token *Token) bool {
+		tokens = append(tokens, token)
+		return true
+	})
+	return tokens
+}
 
-		for _, pt := range tokenPatterns {
-			if loc := pt.pattern.FindStringIndex(remaining); loc != nil && loc[0] == 0 {
-				// Pattern matched at start of remaining text
-				matchedText := remaining[:loc[1]]
-				tokenType := pt.tokenType
-				matchLen := loc[1]
+// decodeRunes returns the runes of text and their sizes in bytes. Invalid
+// bytes are decoded one at a time as utf8.RuneError.
+func decodeRunes(text string) ([]rune, []uint8) {
+	runes := make([]rune, 0, len(text))
+	sizes := make([]uint8, 0, len(text))
+	for len(text) > 0 {
+		r, size := utf8.DecodeRuneInString(text)
+		runes = append(runes, r)
+		sizes = append(sizes, uint8(size))
+		text = text[size:]
+	}
+	return runes, sizes
+}
 
-				// Special handling for whitespace types
-				if tokenType == SpaceTab {
-					if matchedText == "\n" || matchedText == "\r" {
-						tokenType = NewLine
-					}
-				}
+// position is an offset in a text in runes, bytes and UTF-16 code units
+type position struct {
+	rune, byte, utf16 int
+}
 
-				// Special case: Word + "." might be abbreviation
-				if (tokenType == Word || tokenType == WordAlphanumerical) && pos+matchLen < len(runes) && runes[pos+matchLen] == '.' {
-					withDot := matchedText + "."
-					if IsAbbreviation(withDot) {
-						matchedText = withDot
-						tokenType = Abbreviation
-						matchLen++ // Include the dot in length
-					}
-				}
+// spanBetween returns the span from start to end
+func spanBetween(start, end position) Span {
+	return Span{
+		Start:      start.rune,
+		End:        end.rune,
+		StartByte:  start.byte,
+		EndByte:    end.byte,
+		StartUTF16: start.utf16,
+		EndUTF16:   end.utf16,
+	}
+}
 
-				// Keep track of longest match
-				if matchLen > longestMatch.length {
-					longestMatch.text = matchedText
-					longestMatch.tokenType = tokenType
-					longestMatch.length = matchLen
-				}
-			}
+// scan tokenizes runes and calls yield with the accepted tokens until it
+// returns false. sizes holds the size of each rune in bytes and start is
+// the position of the first rune in the whole text. Returns false if yield
+// stopped the scan.
+func (t *TurkishTokenizer) scan(runes []rune, sizes []uint8, start position, yield func(*Token) bool) bool {
+	l := &lexer{runes: runes, abbreviations: t.abbreviations}
+	p := start
+	for pos := 0; pos < len(runes); {
+		end, tokenType := l.next(pos)
+		if end == pos {
+			end = pos + 1
 		}
-
-		// Use the longest match
-		if longestMatch.length > 0 {
-			matched = true
-			if t.acceptedTypes[longestMatch.tokenType] {
-				tokens = append(tokens, &Token{
-					Content:    longestMatch.text,
-					Type:       longestMatch.tokenType,
-					Start:      pos,
-					End:        pos + len([]rune(longestMatch.text)) - 1,
-					Normalized: NormalizeApostrophe(longestMatch.text),
-				})
-			}
-			pos += len([]rune(longestMatch.text))
+		next := p
+		next.rune += end - pos
+		for i := pos; i < end; i++ {
+			next.byte += int(sizes[i])
+			next.utf16 += utf16Len(runes[i])
 		}
-
-		if !matched {
-			// No pattern matched - treat as unknown single character
-			if t.acceptedTypes[Unknown] {
-				tokens = append(tokens, &Token{
-					Content:    string(runes[pos]),
-					Type:       Unknown,
-					Start:      pos,
-					End:        pos,
-					Normalized: string(runes[pos]),
-				})
+		if t.acceptedTypes[tokenType] {
+			content := string(runes[pos:end])
+			normalized := content
+			if tokenType != Emoji {
+				normalized = NormalizeMarks(content)
+			}
+			token := &Token{
+				Content:    content,
+				Type:       tokenType,
+				Span:       spanBetween(p, next),
+				Normalized: NormalizeApostrophe(normalized),
+			}
+			if tokenType == HashTag && t.segmenter != nil {
+				if words := t.segmenter.Segment(string(runes[pos+1 : end])); len(words) > 0 {
+					token.Normalized = strings.Join(words, " ")
+				}
+			}
+			if !yield(token) {
+				return false
 			}
-			pos++
 		}
+		p = next
+		pos = end
 	}
-
-	return tokens
+	return true
 }
 
 // TokenizeToStrings is a convenience method that returns only token contents
@@ -136,6 +136,43 @@ func TestMentionAndHashTag(t *testing.T) {
 	}
 }
 
+// splitInHalf is a WordSegmenter for tests that splits even length text
+// in two
+type splitInHalf struct{}
+
+func (splitInHalf) Segment(text string) []string {
+	runes := []rune(text)
+	if len(runes)%2 != 0 {
+		return nil
+	}
+	return []string{string(runes[:len(runes)/2]), string(runes[len(runes)/2:])}
+}
+
+// TestSegmentHashtags tests that hashtag bodies are split into words
+func TestSegmentHashtags(t *testing.T) {
+	tokenizer := NewBuilder().AcceptAll().IgnoreTypes(SpaceTab).SegmentHashtags(splitInHalf{}).Build()
+	tokens := tokenizer.Tokenize("#yarınokula #tek")
+	want := []struct {
+		typ        TokenType
+		normalized string
+	}{
+		{HashTag, "yarın okula"},
+		{HashTag, "#tek"},
+	}
+	if len(tokens) != len(want) {
+		t.Fatalf("got %d tokens, want %d", len(tokens), len(want))
+	}
+	for i, token := range tokens {
+		if token.Type != want[i].typ || token.Normalized != want[i].normalized {
+			t.Errorf("token %d = %s %q, want %s %q", i, TokenTypeName(token.Type), token.Normalized,
+				TokenTypeName(want[i].typ), want[i].normalized)
+		}
+	}
+	if tokens[0].Content != "#yarınokula" {
+		t.Errorf("content = %q", tokens[0].Content)
+	}
+}
+
 // TestTokenBoundaries tests that start/end positions are correct
 // Ported from Java: TurkishTokenizerTest.testTokenBoundaries()
 func TestTokenBoundaries(t *testing.T) {
@@ -148,16 +185,16 @@ func TestTokenBoundaries(t *testing.T) {
 		t.Fatalf("Expected 4 tokens, got %d: %v", len(tokens), tokens)
 	}
 
-	// Check positions (rune-based, not byte-based)
+	// Check positions (rune offsets, end exclusive)
 	expected := []struct {
 		content string
 		start   int
 		end     int
 	}{
-		{"bir", 0, 2},
-		{"av.", 4, 6},
-		{"geldi", 8, 12},
-		{".", 13, 13},
+		{"bir", 0, 3},
+		{"av.", 4, 7},
+		{"geldi", 8, 13},
+		{".", 13, 14},
 	}
 
 	for i, exp := range expected {